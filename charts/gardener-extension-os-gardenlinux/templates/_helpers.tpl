@@ -9,3 +9,36 @@
 {{- define "deploymentversion" -}}
 apps/v1
 {{- end -}}
+
+{{- define "provisionHooksConfigMapName" -}}
+{{ .Values.controllers.operatingSystemConfig.provisionHooks.configMapName }}
+{{- end -}}
+
+{{- define "controllerConfiguration" -}}
+apiVersion: config.gardenlinux.os.extensions.gardener.cloud/v1alpha1
+kind: ControllerConfiguration
+{{- if .Values.config.clientConnection }}
+clientConnection:
+{{ toYaml .Values.config.clientConnection | indent 2 }}
+{{- end }}
+{{- if .Values.config.featureGates }}
+featureGates:
+{{ toYaml .Values.config.featureGates | indent 2 }}
+{{- end }}
+{{- if .Values.config.nodeTuning }}
+nodeTuning:
+{{ toYaml .Values.config.nodeTuning | indent 2 }}
+{{- end }}
+{{- if .Values.config.containerd }}
+containerd:
+{{ toYaml .Values.config.containerd | indent 2 }}
+{{- end }}
+{{- if .Values.config.inPlaceUpdates }}
+inPlaceUpdates:
+{{ toYaml .Values.config.inPlaceUpdates | indent 2 }}
+{{- end }}
+{{- if .Values.config.provisionHooks }}
+provisionHooks:
+{{ toYaml .Values.config.provisionHooks }}
+{{- end }}
+{{- end -}}
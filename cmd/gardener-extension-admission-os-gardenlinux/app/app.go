@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	admissioncmd "github.com/gardener/gardener-extension-os-gardenlinux/pkg/admission/cmd"
+	gardenlinuxInstall "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/install"
 	memoryOneGardenlinuxInstall "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/install"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
 )
@@ -125,6 +126,7 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 			install.Install(mgr.GetScheme())
 
 			memoryOneGardenlinuxInstall.Install(mgr.GetScheme())
+			gardenlinuxInstall.Install(mgr.GetScheme())
 
 			var sourceCluster cluster.Cluster
 			if sourceClusterConfig != nil {
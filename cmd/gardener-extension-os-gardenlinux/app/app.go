@@ -8,22 +8,24 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 
 	extcontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	controllercmd "github.com/gardener/gardener/extensions/pkg/controller/cmd"
 	"github.com/gardener/gardener/extensions/pkg/controller/heartbeat"
 	heartbeatcmd "github.com/gardener/gardener/extensions/pkg/controller/heartbeat/cmd"
 	osccontroller "github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
-	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	oscmd "github.com/gardener/gardener-extension-os-gardenlinux/pkg/cmd"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/controller/operatingsystemconfig"
 	oscwebhook "github.com/gardener/gardener-extension-os-gardenlinux/pkg/webhook/operatingsystemconfig"
 )
@@ -52,6 +54,12 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 
 		reconcileOpts = &controllercmd.ReconcilerOptions{}
 
+		configFileOpts = &oscmd.ConfigOptions{}
+
+		oscOpts = &oscmd.OperatingSystemConfigOptions{
+			ProvisionHooksConfigMapNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
+		}
+
 		controllerSwitches = controllercmd.NewSwitchOptions(
 			controllercmd.Switch(osccontroller.ControllerName, operatingsystemconfig.AddToManager),
 			controllercmd.Switch(heartbeat.ControllerName, heartbeat.AddToManager),
@@ -80,6 +88,8 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 			ctrlOpts,
 			controllercmd.PrefixOption("heartbeat-", heartbeatCtrlOpts),
 			reconcileOpts,
+			configFileOpts,
+			oscOpts,
 			controllerSwitches,
 			webhookOpts,
 		)
@@ -97,17 +107,18 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 				return err
 			}
 
-			// TODO: Make these flags configurable via command line parameters or component config file.
-			util.ApplyClientConnectionConfigurationToRESTConfig(&componentbaseconfigv1alpha1.ClientConnectionConfiguration{
-				QPS:   100.0,
-				Burst: 130,
-			}, restOpts.Completed().Config)
+			if err := configFileOpts.Completed().ApplyFeatureGates(); err != nil {
+				return fmt.Errorf("error setting feature gates: %w", err)
+			}
+
+			configFileOpts.Completed().ApplyClientConnection(restOpts.Completed().Config)
 
 			completedMgrOpts := mgrOpts.Completed().Options()
 			completedMgrOpts.Client = client.Options{
 				Cache: &client.CacheOptions{
 					DisableFor: []client.Object{
-						&corev1.Secret{}, // applied for OperatingSystemConfig Secret references
+						&corev1.Secret{},    // applied for OperatingSystemConfig Secret references
+						&corev1.ConfigMap{}, // applied for the ConfigMap with the provisioning hooks
 					},
 				},
 			}
@@ -125,6 +136,8 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
 
 			reconcileOpts.Completed().Apply(&operatingsystemconfig.DefaultAddOptions.IgnoreOperationAnnotation, ptr.To(extensionsv1alpha1.ExtensionClassShoot))
+			configFileOpts.Completed().Apply(&operatingsystemconfig.DefaultAddOptions.Actuator)
+			oscOpts.Completed().Apply(&operatingsystemconfig.DefaultAddOptions.Actuator)
 
 			if err := controllerSwitches.Completed().AddToManager(ctx, mgr); err != nil {
 				return fmt.Errorf("could not add controller to manager: %w", err)
@@ -134,6 +147,26 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 				return fmt.Errorf("could not add the mutating webhook to manager: %w", err)
 			}
 
+			if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+				return fmt.Errorf("could not add healthcheck: %w", err)
+			}
+
+			if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
+				return fmt.Errorf("could not add readycheck for informers: %w", err)
+			}
+
+			if !slices.Contains(controllerSwitches.Disabled, osccontroller.ControllerName) {
+				if err := mgr.AddReadyzCheck("operatingsystemconfig-first-sync", operatingsystemconfig.NewFirstSyncChecker(mgr.GetCache())); err != nil {
+					return fmt.Errorf("could not add readycheck for the first sync of the OperatingSystemConfig controller: %w", err)
+				}
+			}
+
+			if !slices.Contains(webhookSwitches.Disabled, oscwebhook.WebhookName) {
+				if err := mgr.AddReadyzCheck("webhook-server", mgr.GetWebhookServer().StartedChecker()); err != nil {
+					return fmt.Errorf("could not add readycheck of webhook to manager: %w", err)
+				}
+			}
+
 			if err := mgr.Start(ctx); err != nil {
 				return fmt.Errorf("error running manager: %w", err)
 			}
@@ -143,6 +176,7 @@ func NewControllerCommand(ctx context.Context) *cobra.Command {
 	}
 
 	aggOption.AddFlags(cmd.Flags())
+	cmd.AddCommand(NewRenderCommand())
 
 	return cmd
 }
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"os"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	oscmd "github.com/gardener/gardener-extension-os-gardenlinux/pkg/cmd"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/render"
+)
+
+// NewRenderCommand returns a new Command which renders an OperatingSystemConfig from local manifests.
+func NewRenderCommand() *cobra.Command {
+	var (
+		files          []string
+		purpose        string
+		oscOpts        = &oscmd.OperatingSystemConfigOptions{}
+		configFileOpts = &oscmd.ConfigOptions{}
+	)
+
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Render an OperatingSystemConfig from local manifests",
+		Long: `Render reads an OperatingSystemConfig and the Secrets referenced by its files from local YAML manifests and
+prints the user data (purpose provision) or the additional units and files (purpose reconcile) which the extension
+produces for it.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := configFileOpts.Complete(); err != nil {
+				return fmt.Errorf("error completing options: %w", err)
+			}
+			if err := oscOpts.Complete(); err != nil {
+				return fmt.Errorf("error completing options: %w", err)
+			}
+			if err := configFileOpts.Completed().ApplyFeatureGates(); err != nil {
+				return fmt.Errorf("error setting feature gates: %w", err)
+			}
+
+			var objects []client.Object
+			for _, file := range files {
+				f, err := os.Open(file) // #nosec G304 -- reading user provided manifests is the purpose of this command
+				if err != nil {
+					return err
+				}
+
+				objs, err := render.ReadObjects(f)
+				_ = f.Close()
+				if err != nil {
+					return fmt.Errorf("failed to read %s: %w", file, err)
+				}
+				objects = append(objects, objs...)
+			}
+
+			opts := render.Options{Purpose: extensionsv1alpha1.OperatingSystemConfigPurpose(purpose)}
+			configFileOpts.Completed().Apply(&opts.Actuator)
+			oscOpts.Completed().Apply(&opts.Actuator)
+
+			return render.Render(cmd.Context(), cmd.OutOrStdout(), objects, opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&files, "file", "f", nil, "Manifests containing the OperatingSystemConfig and the Secrets referenced by its files.")
+	cmd.Flags().StringVar(&purpose, "purpose", "", "Overrides the purpose of the OperatingSystemConfig (provision or reconcile), defaults to provision if the OperatingSystemConfig has none.")
+	configFileOpts.AddFlags(cmd.Flags())
+	oscOpts.AddFlags(cmd.Flags())
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
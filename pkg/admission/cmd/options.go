@@ -14,6 +14,7 @@ import (
 func GardenWebhookSwitchOptions() *webhookcmd.SwitchOptions {
 	return webhookcmd.NewSwitchOptions(
 		webhookcmd.Switch(validator.Name, validator.New),
+		webhookcmd.Switch(validator.GardenLinuxName, validator.NewGardenLinux),
 		//webhookcmd.Switch(validator.SecretsValidatorName, validator.NewSecretsWebhook),
 	)
 }
@@ -5,6 +5,7 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -19,8 +20,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
-	memoryonev1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/v1alpha1"
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	gardenlinuxvalidation "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/validation"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux"
 	memoryonegardenlinuxValidation "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/validation"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/memoryone"
 )
 
@@ -40,7 +44,7 @@ type shoot struct {
 }
 
 // Validate validates the given shoot object.
-func (s *shoot) Validate(ctx context.Context, newObj, _ client.Object) error {
+func (s *shoot) Validate(ctx context.Context, newObj, oldObj client.Object) error {
 	shoot, ok := newObj.(*core.Shoot)
 	if !ok {
 		return fmt.Errorf("wrong object type %T", newObj)
@@ -57,13 +61,28 @@ func (s *shoot) Validate(ctx context.Context, newObj, _ client.Object) error {
 		return err
 	}
 
-	return s.validateShoot(ctx, shoot)
+	var oldShoot *core.Shoot
+	if oldObj != nil {
+		oldShoot, ok = oldObj.(*core.Shoot)
+		if !ok {
+			return fmt.Errorf("wrong object type %T for old object", oldObj)
+		}
+	}
+
+	return s.validateShoot(ctx, shoot, oldShoot)
 }
 
-func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot) error {
+func (s *shoot) validateShoot(_ context.Context, shoot, oldShoot *core.Shoot) error {
 	allErrs := field.ErrorList{}
 	fldPath := field.NewPath("spec", "provider", "workers")
 
+	oldMachineImages := map[string]*core.ShootMachineImage{}
+	if oldShoot != nil {
+		for _, worker := range oldShoot.Spec.Provider.Workers {
+			oldMachineImages[worker.Name] = worker.Machine.Image
+		}
+	}
+
 	for i, worker := range shoot.Spec.Provider.Workers {
 		machineImage := worker.Machine.Image
 
@@ -75,25 +94,61 @@ func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot) error {
 			continue
 		}
 
-		var operatingSystemConfig *memoryonev1alpha1.OperatingSystemConfiguration
+		providerConfigPath := fldPath.Index(i).Child("machine", "image", "providerConfig")
 
-		fldPath = fldPath.Index(i).Child("providerConfig")
-		if err := util.Decode(s.decoder, machineImage.ProviderConfig.Raw, operatingSystemConfig); err != nil {
-			return field.Invalid(fldPath, string(machineImage.ProviderConfig.Raw), "is not a valid OperatingSystemConfiguration")
+		if machineImage.Name == gardenlinux.OSTypeGardenLinux {
+			allErrs = append(allErrs, s.validateGardenLinuxConfiguration(machineImage.ProviderConfig.Raw, providerConfigPath)...)
+			continue
 		}
 
-		if errList := memoryonegardenlinuxValidation.ValidateOperatingSystemConfig(operatingSystemConfig, fldPath); len(errList) != 0 {
-			allErrs = append(allErrs, errList...)
+		// Existing worker pools may hold values which were accepted before they were validated, hence only changed
+		// MemoryOne configurations are validated to not block updates of the shoot.
+		if isUnchangedProviderConfig(oldMachineImages[worker.Name], machineImage) {
+			continue
 		}
+
+		allErrs = append(allErrs, s.validateMemoryOneConfiguration(shoot, machineImage.ProviderConfig.Raw, providerConfigPath)...)
 	}
 
-	if len(allErrs) > 1 {
+	if len(allErrs) > 0 {
 		return allErrs.ToAggregate()
 	}
 
 	return nil
 }
 
+func (s *shoot) validateGardenLinuxConfiguration(raw []byte, fldPath *field.Path) field.ErrorList {
+	operatingSystemConfig := &apisgardenlinux.OperatingSystemConfiguration{}
+	if err := util.Decode(s.decoder, raw, operatingSystemConfig); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(raw), "is not a valid OperatingSystemConfiguration")}
+	}
+
+	return gardenlinuxvalidation.ValidateOperatingSystemConfiguration(operatingSystemConfig, fldPath)
+}
+
+func (s *shoot) validateMemoryOneConfiguration(shoot *core.Shoot, raw []byte, fldPath *field.Path) field.ErrorList {
+	operatingSystemConfig := &memoryonegardenlinux.OperatingSystemConfiguration{}
+	if err := util.Decode(s.decoder, raw, operatingSystemConfig); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(raw), "is not a valid OperatingSystemConfiguration")}
+	}
+
+	// Unknown vSMP parameters are passed through to vSMP, hence they are only reported. The webhook framework does not
+	// support admission warnings, so they are logged here and published in the provider status of the
+	// OperatingSystemConfig by the controller.
+	for _, warning := range memoryonegardenlinuxValidation.WarningsForOperatingSystemConfig(operatingSystemConfig, fldPath) {
+		logger.Info("Warning for MemoryOne configuration", "shoot", client.ObjectKeyFromObject(shoot), "warning", warning)
+	}
+
+	return memoryonegardenlinuxValidation.ValidateOperatingSystemConfig(operatingSystemConfig, fldPath)
+}
+
+func isUnchangedProviderConfig(oldMachineImage, machineImage *core.ShootMachineImage) bool {
+	return oldMachineImage != nil &&
+		oldMachineImage.Name == machineImage.Name &&
+		oldMachineImage.ProviderConfig != nil &&
+		bytes.Equal(oldMachineImage.ProviderConfig.Raw, machineImage.ProviderConfig.Raw)
+}
+
 func isSupportedMachineImage(machineImageName string) bool {
-	return machineImageName == memoryone.OSTypeMemoryOneGardenLinux
+	return machineImageName == memoryone.OSTypeMemoryOneGardenLinux || machineImageName == gardenlinux.OSTypeGardenLinux
 }
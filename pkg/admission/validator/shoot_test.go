@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	gardenlinuxinstall "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/install"
+	memoryoneinstall "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/install"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/memoryone"
+)
+
+func TestValidator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Admission Validator Suite")
+}
+
+var _ = Describe("Shoot validator", func() {
+	var (
+		ctx       = context.Background()
+		validator *shoot
+		shootObj  *core.Shoot
+	)
+
+	worker := func(name, imageName, providerConfig string) core.Worker {
+		image := &core.ShootMachineImage{Name: imageName}
+		if providerConfig != "" {
+			image.ProviderConfig = &runtime.RawExtension{Raw: []byte(providerConfig)}
+		}
+		return core.Worker{Name: name, Machine: core.Machine{Image: image}}
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		gardenlinuxinstall.Install(scheme)
+		memoryoneinstall.Install(scheme)
+
+		validator = &shoot{
+			decoder:        serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder(),
+			lenientDecoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
+		}
+		shootObj = &core.Shoot{}
+	})
+
+	It("should reject a shoot whose provider configs have exactly one validation error", func() {
+		shootObj.Spec.Provider.Workers = []core.Worker{
+			worker("pool-01", gardenlinux.OSTypeGardenLinux, `{"apiVersion":"gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","kernelModules":{"load":["nfsd; reboot"]}}`),
+		}
+
+		Expect(validator.Validate(ctx, shootObj, nil)).To(MatchError(ContainSubstring("spec.provider.workers[0].machine.image.providerConfig.kernelModules.load[0]: Invalid value")))
+	})
+
+	It("should accept a valid MemoryOne configuration", func() {
+		shootObj.Spec.Provider.Workers = []core.Worker{
+			worker("pool-01", memoryone.OSTypeMemoryOneGardenLinux, `{"apiVersion":"memoryone-gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","memoryTopology":"3","systemMemory":"7x","vsmpConfiguration":{"opt_enable":"1","foo":"bar"}}`),
+		}
+
+		Expect(validator.Validate(ctx, shootObj, nil)).To(Succeed())
+	})
+
+	It("should reject invalid MemoryOne configurations with the path of the worker", func() {
+		shootObj.Spec.Provider.Workers = []core.Worker{
+			worker("pool-01", gardenlinux.OSTypeGardenLinux, ""),
+			worker("pool-02", memoryone.OSTypeMemoryOneGardenLinux, `{"apiVersion":"memoryone-gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","memoryTopology":"0"}`),
+			worker("pool-03", memoryone.OSTypeMemoryOneGardenLinux, `{"apiVersion":"memoryone-gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","vsmpConfiguration":{"system_memory":"lots"}}`),
+		}
+
+		err := validator.Validate(ctx, shootObj, nil)
+		Expect(err).To(MatchError(And(
+			ContainSubstring(`spec.provider.workers[1].machine.image.providerConfig.memoryTopology: Invalid value: "0": mem_topology must be a positive integer`),
+			ContainSubstring(`spec.provider.workers[2].machine.image.providerConfig.vsmpConfiguration[system_memory]: Invalid value: "lots"`),
+		)))
+	})
+
+	Context("update", func() {
+		const legacyProviderConfig = `{"apiVersion":"memoryone-gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","memoryTopology":"0"}`
+
+		var oldShootObj *core.Shoot
+
+		BeforeEach(func() {
+			oldShootObj = &core.Shoot{}
+			oldShootObj.Spec.Provider.Workers = []core.Worker{
+				worker("pool-01", memoryone.OSTypeMemoryOneGardenLinux, legacyProviderConfig),
+			}
+		})
+
+		It("should accept an update which leaves an invalid legacy MemoryOne configuration untouched", func() {
+			shootObj.Spec.Provider.Workers = []core.Worker{
+				worker("pool-01", memoryone.OSTypeMemoryOneGardenLinux, legacyProviderConfig),
+			}
+			shootObj.Spec.Provider.Workers[0].Minimum = 3
+
+			Expect(validator.Validate(ctx, shootObj, oldShootObj)).To(Succeed())
+		})
+
+		It("should reject an update which changes a MemoryOne configuration to an invalid one", func() {
+			shootObj.Spec.Provider.Workers = []core.Worker{
+				worker("pool-01", memoryone.OSTypeMemoryOneGardenLinux, `{"apiVersion":"memoryone-gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","memoryTopology":"0","systemMemory":"7x"}`),
+			}
+
+			Expect(validator.Validate(ctx, shootObj, oldShootObj)).To(MatchError(ContainSubstring("spec.provider.workers[0].machine.image.providerConfig.memoryTopology: Invalid value")))
+		})
+
+		It("should reject an invalid MemoryOne configuration of a new worker pool", func() {
+			shootObj.Spec.Provider.Workers = []core.Worker{
+				worker("pool-01", memoryone.OSTypeMemoryOneGardenLinux, legacyProviderConfig),
+				worker("pool-02", memoryone.OSTypeMemoryOneGardenLinux, legacyProviderConfig),
+			}
+
+			Expect(validator.Validate(ctx, shootObj, oldShootObj)).To(MatchError(ContainSubstring("spec.provider.workers[1].machine.image.providerConfig.memoryTopology: Invalid value")))
+		})
+	})
+
+	It("should reject MemoryOne configurations which cannot be decoded", func() {
+		shootObj.Spec.Provider.Workers = []core.Worker{
+			worker("pool-01", memoryone.OSTypeMemoryOneGardenLinux, `{"apiVersion":"memoryone-gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","memoryTopology":2}`),
+		}
+
+		Expect(validator.Validate(ctx, shootObj, nil)).To(MatchError(ContainSubstring("spec.provider.workers[0].machine.image.providerConfig: Invalid value")))
+	})
+})
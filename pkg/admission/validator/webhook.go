@@ -7,33 +7,48 @@ package validator
 import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/memoryone"
 )
 
 const (
 	// Name is a name for a validation webhook.
 	Name = "validator"
+	// GardenLinuxName is a name for the validation webhook of Shoots using Garden Linux machine images.
+	GardenLinuxName = "validator-gardenlinux"
 )
 
 var logger = log.Log.WithName("os-gardenlinux-validator-webhook")
 
-// New creates a new webhook that validates Shoot resources.
+// New creates a new webhook that validates Shoot resources using MemoryOne on Garden Linux machine images.
 func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
-	logger.Info("Setting up webhook", "name", Name)
+	return newWebhook(mgr, Name, "/webhooks/validate", memoryone.OSTypeMemoryOneGardenLinux)
+}
+
+// NewGardenLinux creates a new webhook that validates Shoot resources using Garden Linux machine images.
+func NewGardenLinux(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	return newWebhook(mgr, GardenLinuxName, "/webhooks/validate-gardenlinux", gardenlinux.OSTypeGardenLinux)
+}
+
+func newWebhook(mgr manager.Manager, name, path, osType string) (*extensionswebhook.Webhook, error) {
+	logger.Info("Setting up webhook", "name", name)
 
 	return extensionswebhook.New(mgr, extensionswebhook.Args{
-		Provider:   "",
-		Name:       Name,
-		Path:       "/webhooks/validate",
+		Provider: "",
+		Name:     name,
+		Path:     path,
 		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{
 			NewShootValidator(mgr): {{Obj: &core.Shoot{}}},
 		},
-		Target:     extensionswebhook.TargetSeed,
+		Target: extensionswebhook.TargetSeed,
 		ObjectSelector: &metav1.LabelSelector{
 			MatchLabels: map[string]string{
-				"operatingsystemconfig.extensions.gardener.cloud/memoryone-gardenlinux": "true",
+				v1beta1constants.LabelExtensionOperatingSystemConfigTypePrefix + osType: "true",
 			},
 		},
 	})
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loader
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config/install"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config/validation"
+)
+
+var (
+	scheme *runtime.Scheme
+	codecs serializer.CodecFactory
+)
+
+func init() {
+	scheme = runtime.NewScheme()
+	install.Install(scheme)
+	codecs = serializer.NewCodecFactory(scheme, serializer.EnableStrict)
+}
+
+// LoadFromFile reads, defaults and validates the ControllerConfiguration in the given file.
+func LoadFromFile(filename string) (*config.ControllerConfiguration, error) {
+	data, err := os.ReadFile(filename) // #nosec G304 -- the file is given by the operator of the extension
+	if err != nil {
+		return nil, fmt.Errorf("failed to read controller configuration: %w", err)
+	}
+
+	cfg, err := Load(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return cfg, nil
+}
+
+// Load decodes, defaults and validates the given ControllerConfiguration.
+func Load(data []byte) (*config.ControllerConfiguration, error) {
+	cfg := &config.ControllerConfiguration{}
+	if _, _, err := codecs.UniversalDecoder().Decode(data, nil, cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode controller configuration: %w", err)
+	}
+
+	if errs := validation.ValidateControllerConfiguration(cfg); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid controller configuration: %w", errs.ToAggregate())
+	}
+
+	return cfg, nil
+}
+
+// Default returns the defaulted ControllerConfiguration which applies if no configuration file is given.
+func Default() (*config.ControllerConfiguration, error) {
+	versioned := &v1alpha1.ControllerConfiguration{}
+	scheme.Default(versioned)
+
+	cfg := &config.ControllerConfiguration{}
+	if err := scheme.Convert(versioned, cfg, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert default controller configuration: %w", err)
+	}
+	return cfg, nil
+}
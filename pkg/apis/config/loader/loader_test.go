@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loader_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config/loader"
+)
+
+func TestLoader(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "APIs Config Loader Suite")
+}
+
+var _ = Describe("Loader", func() {
+	Describe("#Default", func() {
+		It("should return the defaults of the extension", func() {
+			cfg, err := loader.Default()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cfg.ClientConnection.QPS).To(Equal(float32(100)))
+			Expect(cfg.ClientConnection.Burst).To(Equal(int32(130)))
+			Expect(cfg.NodeTuning).To(Equal(&config.NodeTuning{KernelModules: []string{"nfsd"}}))
+			Expect(cfg.Containerd).To(Equal(&config.Containerd{
+				LimitMEMLOCK: ptr.To[int64](67108864),
+				LimitNOFILE:  ptr.To[int64](1048576),
+			}))
+			Expect(cfg.InPlaceUpdates).To(Equal(&config.InPlaceUpdates{ScriptPath: ptr.To("/opt/gardener/bin/inplace-update.sh")}))
+			Expect(cfg.ProvisionHooks).To(BeEmpty())
+		})
+	})
+
+	Describe("#Load", func() {
+		It("should decode and default the configuration", func() {
+			cfg, err := loader.Load([]byte(`apiVersion: config.gardenlinux.os.extensions.gardener.cloud/v1alpha1
+kind: ControllerConfiguration
+featureGates:
+  ProvisionReport: false
+nodeTuning:
+  kernelModules: []
+containerd:
+  limitNOFILE: 65536
+provisionHooks:
+- name: enroll-agent
+  point: pre
+  script: |
+    true
+`))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cfg.ClientConnection.QPS).To(Equal(float32(100)))
+			Expect(cfg.FeatureGates).To(Equal(map[string]bool{"ProvisionReport": false}))
+			Expect(cfg.NodeTuning.KernelModules).To(BeEmpty())
+			Expect(cfg.Containerd).To(Equal(&config.Containerd{
+				LimitMEMLOCK: ptr.To[int64](67108864),
+				LimitNOFILE:  ptr.To[int64](65536),
+			}))
+			Expect(cfg.ProvisionHooks).To(ConsistOf(config.ProvisionHook{Name: "enroll-agent", Point: config.ProvisionHookPointPre, Script: "true\n"}))
+		})
+
+		It("should reject unknown fields", func() {
+			_, err := loader.Load([]byte(`apiVersion: config.gardenlinux.os.extensions.gardener.cloud/v1alpha1
+kind: ControllerConfiguration
+nodeTuning:
+  modules: [nfsd]
+`))
+			Expect(err).To(MatchError(ContainSubstring(`unknown field "nodeTuning.modules"`)))
+		})
+
+		It("should reject an invalid configuration", func() {
+			_, err := loader.Load([]byte(`apiVersion: config.gardenlinux.os.extensions.gardener.cloud/v1alpha1
+kind: ControllerConfiguration
+containerd:
+  limitMEMLOCK: 0
+`))
+			Expect(err).To(MatchError(ContainSubstring("containerd.limitMEMLOCK: Invalid value: 0: must be positive")))
+		})
+	})
+
+	Describe("#LoadFromFile", func() {
+		It("should load the configuration from the given file", func() {
+			filename := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+			Expect(os.WriteFile(filename, []byte(`apiVersion: config.gardenlinux.os.extensions.gardener.cloud/v1alpha1
+kind: ControllerConfiguration
+inPlaceUpdates:
+  scriptPath: /usr/local/bin/update-os.sh
+`), 0600)).To(Succeed())
+
+			cfg, err := loader.LoadFromFile(filename)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.InPlaceUpdates.ScriptPath).To(Equal(ptr.To("/usr/local/bin/update-os.sh")))
+		})
+
+		It("should fail if the file does not exist", func() {
+			_, err := loader.LoadFromFile(filepath.Join(GinkgoT().TempDir(), "config.yaml"))
+			Expect(err).To(MatchError(ContainSubstring("failed to read controller configuration")))
+		})
+	})
+})
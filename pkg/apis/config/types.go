@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControllerConfiguration defines the configuration for the Garden Linux extension.
+type ControllerConfiguration struct {
+	metav1.TypeMeta
+
+	// ClientConnection specifies the kubeconfig file and the settings of the connection to the API server.
+	ClientConnection *componentbaseconfigv1alpha1.ClientConnectionConfiguration
+	// FeatureGates is a map of feature names to bools that enable or disable features of the extension.
+	FeatureGates map[string]bool
+	// NodeTuning contains the defaults for the tuning of the nodes.
+	NodeTuning *NodeTuning
+	// Containerd contains the settings of containerd on all nodes.
+	Containerd *Containerd
+	// InPlaceUpdates contains the settings of in-place updates of the operating system.
+	InPlaceUpdates *InPlaceUpdates
+	// ProvisionHooks are scripts which run on the nodes before or after the built-in provisioning steps.
+	ProvisionHooks []ProvisionHook
+}
+
+// NodeTuning contains the defaults for the tuning of the nodes which apply unless the provider config of a worker pool
+// overrides them.
+type NodeTuning struct {
+	// KernelModules are the kernel modules which are loaded if the provider config does not configure kernel modules.
+	KernelModules []string
+	// Sysctls are kernel parameters which are set on all nodes. The profiles and parameters of the provider config take
+	// precedence.
+	Sysctls map[string]string
+}
+
+// Containerd contains the settings of containerd on all nodes.
+type Containerd struct {
+	// LimitMEMLOCK is the maximum size in bytes of the memory which containerd and its children may lock.
+	LimitMEMLOCK *int64
+	// LimitNOFILE is the maximum number of files which containerd and its children may open.
+	// For containerd 2.x, it is the hard limit while the soft limit of the containers stays at 1024.
+	LimitNOFILE *int64
+}
+
+// InPlaceUpdates contains the settings of in-place updates of the operating system.
+type InPlaceUpdates struct {
+	// ScriptPath is the path on the nodes to which the script which updates the operating system is written.
+	ScriptPath *string
+}
+
+// ProvisionHook is a script which runs on the nodes before or after the built-in provisioning steps.
+type ProvisionHook struct {
+	// Name is the name of the hook. It is unique among all hooks.
+	Name string
+	// Point is the point of the provisioning at which the hook runs.
+	Point ProvisionHookPoint
+	// OSTypes are the types of the OperatingSystemConfigs for which the hook runs. If empty, it runs for all types.
+	OSTypes []string
+	// WorkerPools are the names of the worker pools for which the hook runs. If empty, it runs for all worker pools.
+	WorkerPools []string
+	// Script is the script of the hook. It is run by bash unless it starts with a shebang. The provisioning fails if
+	// it exits with a non-zero status.
+	Script string
+}
+
+// ProvisionHookPoint is the point of the provisioning at which a hook runs.
+type ProvisionHookPoint string
+
+const (
+	// ProvisionHookPointPre runs the hook before the files are written. With user data formats which write all files
+	// before any command runs, it runs after the files are written and before the node is configured.
+	ProvisionHookPointPre ProvisionHookPoint = "pre"
+	// ProvisionHookPointPost runs the hook after the units are started.
+	ProvisionHookPointPost ProvisionHookPoint = "post"
+)
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_ControllerConfiguration sets the defaults for the configuration of the Garden Linux extension
+func SetDefaults_ControllerConfiguration(obj *ControllerConfiguration) {
+	if obj.ClientConnection == nil {
+		obj.ClientConnection = &componentbaseconfigv1alpha1.ClientConnectionConfiguration{}
+	}
+	if obj.NodeTuning == nil {
+		obj.NodeTuning = &NodeTuning{}
+	}
+	if obj.Containerd == nil {
+		obj.Containerd = &Containerd{}
+	}
+	if obj.InPlaceUpdates == nil {
+		obj.InPlaceUpdates = &InPlaceUpdates{}
+	}
+}
+
+// SetDefaults_ClientConnectionConfiguration sets the defaults for the connection to the API server
+func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
+	if obj.QPS == 0 {
+		obj.QPS = 100
+	}
+	if obj.Burst == 0 {
+		obj.Burst = 130
+	}
+}
+
+// SetDefaults_NodeTuning sets the defaults for the tuning of the nodes
+func SetDefaults_NodeTuning(obj *NodeTuning) {
+	if obj.KernelModules == nil {
+		obj.KernelModules = []string{"nfsd"}
+	}
+}
+
+// SetDefaults_Containerd sets the defaults for containerd
+func SetDefaults_Containerd(obj *Containerd) {
+	if obj.LimitMEMLOCK == nil {
+		obj.LimitMEMLOCK = ptr.To[int64](67108864)
+	}
+	if obj.LimitNOFILE == nil {
+		obj.LimitNOFILE = ptr.To[int64](1048576)
+	}
+}
+
+// SetDefaults_InPlaceUpdates sets the defaults for in-place updates
+func SetDefaults_InPlaceUpdates(obj *InPlaceUpdates) {
+	if obj.ScriptPath == nil {
+		obj.ScriptPath = ptr.To("/opt/gardener/bin/inplace-update.sh")
+	}
+}
@@ -0,0 +1,201 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	config "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Containerd)(nil), (*config.Containerd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Containerd_To_config_Containerd(a.(*Containerd), b.(*config.Containerd), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Containerd)(nil), (*Containerd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Containerd_To_v1alpha1_Containerd(a.(*config.Containerd), b.(*Containerd), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ControllerConfiguration)(nil), (*ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(a.(*config.ControllerConfiguration), b.(*ControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InPlaceUpdates)(nil), (*config.InPlaceUpdates)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InPlaceUpdates_To_config_InPlaceUpdates(a.(*InPlaceUpdates), b.(*config.InPlaceUpdates), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.InPlaceUpdates)(nil), (*InPlaceUpdates)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_InPlaceUpdates_To_v1alpha1_InPlaceUpdates(a.(*config.InPlaceUpdates), b.(*InPlaceUpdates), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeTuning)(nil), (*config.NodeTuning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeTuning_To_config_NodeTuning(a.(*NodeTuning), b.(*config.NodeTuning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NodeTuning)(nil), (*NodeTuning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NodeTuning_To_v1alpha1_NodeTuning(a.(*config.NodeTuning), b.(*NodeTuning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProvisionHook)(nil), (*config.ProvisionHook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProvisionHook_To_config_ProvisionHook(a.(*ProvisionHook), b.(*config.ProvisionHook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProvisionHook)(nil), (*ProvisionHook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProvisionHook_To_v1alpha1_ProvisionHook(a.(*config.ProvisionHook), b.(*ProvisionHook), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_Containerd_To_config_Containerd(in *Containerd, out *config.Containerd, s conversion.Scope) error {
+	out.LimitMEMLOCK = (*int64)(unsafe.Pointer(in.LimitMEMLOCK))
+	out.LimitNOFILE = (*int64)(unsafe.Pointer(in.LimitNOFILE))
+	return nil
+}
+
+// Convert_v1alpha1_Containerd_To_config_Containerd is an autogenerated conversion function.
+func Convert_v1alpha1_Containerd_To_config_Containerd(in *Containerd, out *config.Containerd, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Containerd_To_config_Containerd(in, out, s)
+}
+
+func autoConvert_config_Containerd_To_v1alpha1_Containerd(in *config.Containerd, out *Containerd, s conversion.Scope) error {
+	out.LimitMEMLOCK = (*int64)(unsafe.Pointer(in.LimitMEMLOCK))
+	out.LimitNOFILE = (*int64)(unsafe.Pointer(in.LimitNOFILE))
+	return nil
+}
+
+// Convert_config_Containerd_To_v1alpha1_Containerd is an autogenerated conversion function.
+func Convert_config_Containerd_To_v1alpha1_Containerd(in *config.Containerd, out *Containerd, s conversion.Scope) error {
+	return autoConvert_config_Containerd_To_v1alpha1_Containerd(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
+	out.ClientConnection = (*configv1alpha1.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.NodeTuning = (*config.NodeTuning)(unsafe.Pointer(in.NodeTuning))
+	out.Containerd = (*config.Containerd)(unsafe.Pointer(in.Containerd))
+	out.InPlaceUpdates = (*config.InPlaceUpdates)(unsafe.Pointer(in.InPlaceUpdates))
+	out.ProvisionHooks = *(*[]config.ProvisionHook)(unsafe.Pointer(&in.ProvisionHooks))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in *config.ControllerConfiguration, out *ControllerConfiguration, s conversion.Scope) error {
+	out.ClientConnection = (*configv1alpha1.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.NodeTuning = (*NodeTuning)(unsafe.Pointer(in.NodeTuning))
+	out.Containerd = (*Containerd)(unsafe.Pointer(in.Containerd))
+	out.InPlaceUpdates = (*InPlaceUpdates)(unsafe.Pointer(in.InPlaceUpdates))
+	out.ProvisionHooks = *(*[]ProvisionHook)(unsafe.Pointer(&in.ProvisionHooks))
+	return nil
+}
+
+// Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in *config.ControllerConfiguration, out *ControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_InPlaceUpdates_To_config_InPlaceUpdates(in *InPlaceUpdates, out *config.InPlaceUpdates, s conversion.Scope) error {
+	out.ScriptPath = (*string)(unsafe.Pointer(in.ScriptPath))
+	return nil
+}
+
+// Convert_v1alpha1_InPlaceUpdates_To_config_InPlaceUpdates is an autogenerated conversion function.
+func Convert_v1alpha1_InPlaceUpdates_To_config_InPlaceUpdates(in *InPlaceUpdates, out *config.InPlaceUpdates, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InPlaceUpdates_To_config_InPlaceUpdates(in, out, s)
+}
+
+func autoConvert_config_InPlaceUpdates_To_v1alpha1_InPlaceUpdates(in *config.InPlaceUpdates, out *InPlaceUpdates, s conversion.Scope) error {
+	out.ScriptPath = (*string)(unsafe.Pointer(in.ScriptPath))
+	return nil
+}
+
+// Convert_config_InPlaceUpdates_To_v1alpha1_InPlaceUpdates is an autogenerated conversion function.
+func Convert_config_InPlaceUpdates_To_v1alpha1_InPlaceUpdates(in *config.InPlaceUpdates, out *InPlaceUpdates, s conversion.Scope) error {
+	return autoConvert_config_InPlaceUpdates_To_v1alpha1_InPlaceUpdates(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodeTuning_To_config_NodeTuning(in *NodeTuning, out *config.NodeTuning, s conversion.Scope) error {
+	out.KernelModules = *(*[]string)(unsafe.Pointer(&in.KernelModules))
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	return nil
+}
+
+// Convert_v1alpha1_NodeTuning_To_config_NodeTuning is an autogenerated conversion function.
+func Convert_v1alpha1_NodeTuning_To_config_NodeTuning(in *NodeTuning, out *config.NodeTuning, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeTuning_To_config_NodeTuning(in, out, s)
+}
+
+func autoConvert_config_NodeTuning_To_v1alpha1_NodeTuning(in *config.NodeTuning, out *NodeTuning, s conversion.Scope) error {
+	out.KernelModules = *(*[]string)(unsafe.Pointer(&in.KernelModules))
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	return nil
+}
+
+// Convert_config_NodeTuning_To_v1alpha1_NodeTuning is an autogenerated conversion function.
+func Convert_config_NodeTuning_To_v1alpha1_NodeTuning(in *config.NodeTuning, out *NodeTuning, s conversion.Scope) error {
+	return autoConvert_config_NodeTuning_To_v1alpha1_NodeTuning(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProvisionHook_To_config_ProvisionHook(in *ProvisionHook, out *config.ProvisionHook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Point = config.ProvisionHookPoint(in.Point)
+	out.OSTypes = *(*[]string)(unsafe.Pointer(&in.OSTypes))
+	out.WorkerPools = *(*[]string)(unsafe.Pointer(&in.WorkerPools))
+	out.Script = in.Script
+	return nil
+}
+
+// Convert_v1alpha1_ProvisionHook_To_config_ProvisionHook is an autogenerated conversion function.
+func Convert_v1alpha1_ProvisionHook_To_config_ProvisionHook(in *ProvisionHook, out *config.ProvisionHook, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProvisionHook_To_config_ProvisionHook(in, out, s)
+}
+
+func autoConvert_config_ProvisionHook_To_v1alpha1_ProvisionHook(in *config.ProvisionHook, out *ProvisionHook, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Point = ProvisionHookPoint(in.Point)
+	out.OSTypes = *(*[]string)(unsafe.Pointer(&in.OSTypes))
+	out.WorkerPools = *(*[]string)(unsafe.Pointer(&in.WorkerPools))
+	out.Script = in.Script
+	return nil
+}
+
+// Convert_config_ProvisionHook_To_v1alpha1_ProvisionHook is an autogenerated conversion function.
+func Convert_config_ProvisionHook_To_v1alpha1_ProvisionHook(in *config.ProvisionHook, out *ProvisionHook, s conversion.Scope) error {
+	return autoConvert_config_ProvisionHook_To_v1alpha1_ProvisionHook(in, out, s)
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+
+	gardenervalidation "github.com/gardener/gardener/pkg/utils/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/component-base/featuregate"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	gardenlinuxvalidation "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/validation"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/features"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/memoryone"
+)
+
+// maxProvisionHookScriptSize is the maximum size in bytes of the script of a provisioning hook.
+const maxProvisionHookScriptSize = 16 * 1024
+
+var (
+	supportedProvisionHookPoints = sets.New(config.ProvisionHookPointPre, config.ProvisionHookPointPost)
+	supportedProvisionHookTypes  = sets.New(gardenlinux.OSTypeGardenLinux, memoryone.OSTypeMemoryOneGardenLinux)
+)
+
+// ValidateControllerConfiguration validates the given configuration of the Garden Linux extension.
+func ValidateControllerConfiguration(cfg *config.ControllerConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ClientConnection != nil {
+		allErrs = append(allErrs, gardenervalidation.ValidateClientConnectionConfiguration(cfg.ClientConnection, field.NewPath("clientConnection"))...)
+	}
+
+	for name := range cfg.FeatureGates {
+		if _, ok := features.AllFeatureGates[featuregate.Feature(name)]; !ok {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("featureGates").Key(name), name, "unknown feature gate"))
+		}
+	}
+
+	if cfg.NodeTuning != nil {
+		allErrs = append(allErrs, validateNodeTuning(cfg.NodeTuning, field.NewPath("nodeTuning"))...)
+	}
+
+	if cfg.Containerd != nil {
+		allErrs = append(allErrs, validateContainerd(cfg.Containerd, field.NewPath("containerd"))...)
+	}
+
+	if cfg.InPlaceUpdates != nil && cfg.InPlaceUpdates.ScriptPath != nil {
+		allErrs = append(allErrs, gardenlinuxvalidation.ValidateAbsolutePath(*cfg.InPlaceUpdates.ScriptPath, field.NewPath("inPlaceUpdates", "scriptPath"))...)
+	}
+
+	allErrs = append(allErrs, ValidateProvisionHooks(cfg.ProvisionHooks, field.NewPath("provisionHooks"))...)
+
+	return allErrs
+}
+
+func validateNodeTuning(tuning *config.NodeTuning, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	modules := sets.New[string]()
+	for i, name := range tuning.KernelModules {
+		idxPath := fldPath.Child("kernelModules").Index(i)
+		allErrs = append(allErrs, gardenlinuxvalidation.ValidateKernelModuleName(name, idxPath)...)
+		if modules.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		modules.Insert(name)
+	}
+
+	allErrs = append(allErrs, gardenlinuxvalidation.ValidateSysctlParameters(tuning.Sysctls, fldPath.Child("sysctls"))...)
+
+	return allErrs
+}
+
+func validateContainerd(containerd *config.Containerd, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if containerd.LimitMEMLOCK != nil && *containerd.LimitMEMLOCK <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("limitMEMLOCK"), *containerd.LimitMEMLOCK, "must be positive"))
+	}
+	if containerd.LimitNOFILE != nil && *containerd.LimitNOFILE <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("limitNOFILE"), *containerd.LimitNOFILE, "must be positive"))
+	}
+
+	return allErrs
+}
+
+// ValidateProvisionHooks validates the given provisioning hooks.
+func ValidateProvisionHooks(hooks []config.ProvisionHook, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, hook := range hooks {
+		idxPath := fldPath.Index(i)
+
+		for _, msg := range validation.IsDNS1123Label(hook.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), hook.Name, msg))
+		}
+		if names.Has(hook.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), hook.Name))
+		}
+		names.Insert(hook.Name)
+
+		if !supportedProvisionHookPoints.Has(hook.Point) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("point"), hook.Point, sets.List(supportedProvisionHookPoints)))
+		}
+
+		for j, osType := range hook.OSTypes {
+			if !supportedProvisionHookTypes.Has(osType) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("osTypes").Index(j), osType, sets.List(supportedProvisionHookTypes)))
+			}
+		}
+
+		for j, pool := range hook.WorkerPools {
+			for _, msg := range validation.IsDNS1123Label(pool) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("workerPools").Index(j), pool, msg))
+			}
+		}
+
+		if strings.TrimSpace(hook.Script) == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("script"), "script must not be empty"))
+		} else if len(hook.Script) > maxProvisionHookScriptSize {
+			allErrs = append(allErrs, field.TooLong(idxPath.Child("script"), "", maxProvisionHookScriptSize))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	. "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config/validation"
+)
+
+func TestValidation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "APIs Config Validation Suite")
+}
+
+var _ = Describe("ControllerConfiguration", func() {
+	var cfg *config.ControllerConfiguration
+
+	BeforeEach(func() {
+		cfg = &config.ControllerConfiguration{
+			ClientConnection: &componentbaseconfigv1alpha1.ClientConnectionConfiguration{QPS: 100, Burst: 130},
+			FeatureGates:     map[string]bool{"ProvisionReport": false},
+			NodeTuning: &config.NodeTuning{
+				KernelModules: []string{"nfsd", "br_netfilter"},
+				Sysctls:       map[string]string{"vm.max_map_count": "262144"},
+			},
+			Containerd: &config.Containerd{
+				LimitMEMLOCK: ptr.To[int64](67108864),
+				LimitNOFILE:  ptr.To[int64](1048576),
+			},
+			InPlaceUpdates: &config.InPlaceUpdates{ScriptPath: ptr.To("/opt/gardener/bin/inplace-update.sh")},
+			ProvisionHooks: []config.ProvisionHook{
+				{Name: "enroll-agent", Point: config.ProvisionHookPointPre, OSTypes: []string{"gardenlinux"}, WorkerPools: []string{"gpu"}, Script: "true\n"},
+			},
+		}
+	})
+
+	It("should accept a valid configuration", func() {
+		Expect(ValidateControllerConfiguration(cfg)).To(BeEmpty())
+	})
+
+	It("should accept an empty configuration", func() {
+		Expect(ValidateControllerConfiguration(&config.ControllerConfiguration{})).To(BeEmpty())
+	})
+
+	It("should reject an invalid client connection", func() {
+		cfg.ClientConnection.Burst = -1
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("clientConnection.burst"),
+		}))))
+	})
+
+	It("should reject unknown feature gates", func() {
+		cfg.FeatureGates["Foo"] = true
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("featureGates[Foo]"),
+		}))))
+	})
+
+	It("should reject invalid and duplicate kernel modules", func() {
+		cfg.NodeTuning.KernelModules = []string{"nfsd", "nfsd", "foo bar"}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("nodeTuning.kernelModules[1]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("nodeTuning.kernelModules[2]"),
+			})),
+		))
+	})
+
+	It("should reject invalid kernel parameters", func() {
+		cfg.NodeTuning.Sysctls = map[string]string{"vm/max map count": "1"}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Field": HavePrefix("nodeTuning.sysctls"),
+		}))))
+	})
+
+	It("should reject non-positive containerd limits", func() {
+		cfg.Containerd.LimitMEMLOCK = ptr.To[int64](0)
+		cfg.Containerd.LimitNOFILE = ptr.To[int64](-1)
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("containerd.limitMEMLOCK"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("containerd.limitNOFILE"),
+			})),
+		))
+	})
+
+	It("should reject a relative path of the in-place update script", func() {
+		cfg.InPlaceUpdates.ScriptPath = ptr.To("bin/inplace-update.sh")
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Field": Equal("inPlaceUpdates.scriptPath"),
+		}))))
+	})
+
+	Describe("#ValidateProvisionHooks", func() {
+		fldPath := field.NewPath("hooks")
+
+		It("should reject invalid hooks", func() {
+			hooks := []config.ProvisionHook{
+				{Name: "Foo", Point: "during", OSTypes: []string{"ubuntu"}, WorkerPools: []string{"GPU"}, Script: " \n"},
+				{Name: "bar", Point: config.ProvisionHookPointPost, Script: strings.Repeat("x", 16*1024+1)},
+				{Name: "bar", Point: config.ProvisionHookPointPost, Script: "true"},
+			}
+
+			Expect(ValidateProvisionHooks(hooks, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("hooks[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("hooks[0].point"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("hooks[0].osTypes[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("hooks[0].workerPools[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("hooks[0].script"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooLong),
+					"Field": Equal("hooks[1].script"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("hooks[2].name"),
+				})),
+			))
+		})
+	})
+})
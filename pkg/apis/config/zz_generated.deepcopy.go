@@ -0,0 +1,175 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package config
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1alpha1 "k8s.io/component-base/config/v1alpha1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Containerd) DeepCopyInto(out *Containerd) {
+	*out = *in
+	if in.LimitMEMLOCK != nil {
+		in, out := &in.LimitMEMLOCK, &out.LimitMEMLOCK
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LimitNOFILE != nil {
+		in, out := &in.LimitNOFILE, &out.LimitNOFILE
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Containerd.
+func (in *Containerd) DeepCopy() *Containerd {
+	if in == nil {
+		return nil
+	}
+	out := new(Containerd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.ClientConnection != nil {
+		in, out := &in.ClientConnection, &out.ClientConnection
+		*out = new(v1alpha1.ClientConnectionConfiguration)
+		**out = **in
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTuning != nil {
+		in, out := &in.NodeTuning, &out.NodeTuning
+		*out = new(NodeTuning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Containerd != nil {
+		in, out := &in.Containerd, &out.Containerd
+		*out = new(Containerd)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InPlaceUpdates != nil {
+		in, out := &in.InPlaceUpdates, &out.InPlaceUpdates
+		*out = new(InPlaceUpdates)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProvisionHooks != nil {
+		in, out := &in.ProvisionHooks, &out.ProvisionHooks
+		*out = make([]ProvisionHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerConfiguration.
+func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControllerConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InPlaceUpdates) DeepCopyInto(out *InPlaceUpdates) {
+	*out = *in
+	if in.ScriptPath != nil {
+		in, out := &in.ScriptPath, &out.ScriptPath
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceUpdates.
+func (in *InPlaceUpdates) DeepCopy() *InPlaceUpdates {
+	if in == nil {
+		return nil
+	}
+	out := new(InPlaceUpdates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTuning) DeepCopyInto(out *NodeTuning) {
+	*out = *in
+	if in.KernelModules != nil {
+		in, out := &in.KernelModules, &out.KernelModules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTuning.
+func (in *NodeTuning) DeepCopy() *NodeTuning {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTuning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionHook) DeepCopyInto(out *ProvisionHook) {
+	*out = *in
+	if in.OSTypes != nil {
+		in, out := &in.OSTypes, &out.OSTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionHook.
+func (in *ProvisionHook) DeepCopy() *ProvisionHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionHook)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+// +groupName="gardenlinux.os.extensions.gardener.cloud"
+
+//go:generate ../../../hack/update-codegen.sh
+
+package gardenlinux // import "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
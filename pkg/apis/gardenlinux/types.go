@@ -0,0 +1,325 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenlinux
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OperatingSystemConfiguration allows to specify configuration for Garden Linux nodes of a worker pool.
+type OperatingSystemConfiguration struct {
+	metav1.TypeMeta
+
+	// KernelModules configures the kernel modules which are loaded or blacklisted on the nodes.
+	KernelModules *KernelModules
+	// Sysctls configures the kernel parameters which are set on the nodes.
+	Sysctls *Sysctls
+	// UserDataFormat is the format of the user data which provisions the nodes.
+	UserDataFormat *UserDataFormat
+	// Containerd configures containerd on the nodes.
+	Containerd *Containerd
+	// NetworkReadiness configures the checks which must succeed before the nodes are provisioned.
+	NetworkReadiness *NetworkReadiness
+	// Network configures systemd-networkd on the nodes.
+	Network *Network
+	// Swap configures swap space on the nodes.
+	Swap *Swap
+	// NTP configures the time synchronisation of the nodes.
+	NTP *NTP
+	// CABundles is a list of PEM encoded CA certificate bundles which are added to the trust store of the nodes.
+	CABundles []CABundle
+	// LocalStorage configures a RAID0 array of the local disks of the nodes which stores the data of containerd and the
+	// kubelet.
+	LocalStorage *LocalStorage
+}
+
+// KernelModules contains the kernel modules to load and to blacklist.
+type KernelModules struct {
+	// Load is a list of kernel modules which are loaded during boot.
+	Load []string
+	// Blacklist is a list of kernel modules which must not be loaded automatically.
+	Blacklist []string
+}
+
+// Sysctls contains the kernel parameters to set.
+type Sysctls struct {
+	// Profiles is a list of built-in profiles whose kernel parameters are set. Profiles listed later take precedence.
+	Profiles []SysctlProfile
+	// Parameters is a map of kernel parameters and their values. They take precedence over the parameters of the profiles.
+	Parameters map[string]string
+}
+
+// SysctlProfile is the name of a built-in set of kernel parameters.
+type SysctlProfile string
+
+const (
+	// SysctlProfileHighConnectionCount tunes the network stack for nodes handling a high number of concurrent connections.
+	SysctlProfileHighConnectionCount SysctlProfile = "high-connection-count"
+	// SysctlProfileElasticsearch raises the limits required by Elasticsearch and other heavy mmap users.
+	SysctlProfileElasticsearch SysctlProfile = "elasticsearch"
+)
+
+// UserDataFormat is a format of the user data which provisions the nodes.
+type UserDataFormat string
+
+const (
+	// UserDataFormatBash renders the user data as bash script.
+	UserDataFormatBash UserDataFormat = "bash"
+	// UserDataFormatCloudConfig renders the user data as cloud-init `#cloud-config` document.
+	UserDataFormatCloudConfig UserDataFormat = "cloud-config"
+	// UserDataFormatMIMEMultipart renders the user data as MIME multipart document containing a cloud-init
+	// `#cloud-config` part which writes the files and a shell script part which runs the provisioning commands.
+	UserDataFormatMIMEMultipart UserDataFormat = "mime-multipart"
+	// UserDataFormatIgnition renders the user data as Ignition (spec v3) config.
+	UserDataFormatIgnition UserDataFormat = "ignition"
+)
+
+// Containerd contains the configuration of containerd.
+type Containerd struct {
+	// MajorVersion is the major version of containerd which is installed on the machine image, either `1` or `2`.
+	MajorVersion *int32
+}
+
+// NetworkReadiness contains the checks which must succeed before the nodes are provisioned. The checks are retried
+// until all of them succeed or the timeout expires, in which case the provisioning fails.
+type NetworkReadiness struct {
+	// NetworkOnline specifies whether `network-online.target` must be active.
+	NetworkOnline *bool
+	// ResolveHostname specifies whether the hostname of the node must be resolvable. If not, systemd-networkd is
+	// restarted once if the hostname cannot be resolved.
+	ResolveHostname *bool
+	// Endpoints is a list of TCP endpoints in the form `host:port` which must be reachable.
+	Endpoints []string
+	// Timeout is the duration after which the provisioning fails if the checks did not succeed.
+	Timeout *metav1.Duration
+	// RetryInterval is the duration between two attempts of the checks.
+	RetryInterval *metav1.Duration
+}
+
+// Network contains the configuration of systemd-networkd.
+type Network struct {
+	// Interfaces is a list of configurations of network interfaces. Each configuration is written to a separate
+	// `.network` file which takes precedence over the default configuration of the matched interfaces.
+	Interfaces []NetworkInterface
+}
+
+// NetworkInterface contains the configuration of the network interfaces matched by its match rules.
+type NetworkInterface struct {
+	// Name is the name of the configuration. It is part of the name of the `.network` file.
+	Name string
+	// Match selects the network interfaces which are configured.
+	Match NetworkMatch
+	// MTU is the maximum transmission unit of the interfaces in bytes.
+	MTU *int32
+	// DHCP configures DHCP on the interfaces.
+	DHCP *DHCP
+	// Addresses is a list of static addresses of the interfaces in CIDR notation.
+	Addresses []string
+	// Routes is a list of static routes which are added for the interfaces.
+	Routes []Route
+}
+
+// NetworkMatch contains the rules which select network interfaces. An interface is selected if it matches all given
+// rules and one of the values of each rule.
+type NetworkMatch struct {
+	// Names is a list of interface names. Shell-style globs are supported.
+	Names []string
+	// MACAddresses is a list of hardware addresses.
+	MACAddresses []string
+	// Drivers is a list of driver names. Shell-style globs are supported.
+	Drivers []string
+}
+
+// DHCP contains the DHCP configuration of network interfaces.
+type DHCP struct {
+	// Mode selects the IP versions for which DHCP is enabled.
+	Mode *DHCPMode
+	// UseDNS specifies whether the DNS servers received via DHCP are used.
+	UseDNS *bool
+	// UseMTU specifies whether the MTU received via DHCPv4 is used.
+	UseMTU *bool
+	// RouteMetric is the metric of the routes received via DHCPv4.
+	RouteMetric *int32
+}
+
+// DHCPMode is a mode of DHCP.
+type DHCPMode string
+
+const (
+	// DHCPModeYes enables DHCP for IPv4 and IPv6.
+	DHCPModeYes DHCPMode = "yes"
+	// DHCPModeNo disables DHCP.
+	DHCPModeNo DHCPMode = "no"
+	// DHCPModeIPv4 enables DHCP for IPv4 only.
+	DHCPModeIPv4 DHCPMode = "ipv4"
+	// DHCPModeIPv6 enables DHCP for IPv6 only.
+	DHCPModeIPv6 DHCPMode = "ipv6"
+)
+
+// Route is a static route.
+type Route struct {
+	// Destination is the destination network of the route in CIDR notation.
+	Destination string
+	// Gateway is the address of the gateway of the route.
+	Gateway *string
+	// Metric is the metric of the route.
+	Metric *int32
+}
+
+// Swap contains the configuration of swap space. Exactly one of zram device and swap file must be configured.
+type Swap struct {
+	// Zram configures a compressed swap device in memory.
+	Zram *Zram
+	// File configures a swap file on the disk.
+	File *SwapFile
+	// Behavior is the swap behavior of the kubelet for the pods on the nodes.
+	Behavior *SwapBehavior
+}
+
+// Zram contains the configuration of a compressed swap device in memory.
+type Zram struct {
+	// Size is the size of the uncompressed data which the device can hold.
+	Size resource.Quantity
+	// CompressionAlgorithm is the compression algorithm of the device.
+	CompressionAlgorithm *string
+}
+
+// SwapFile contains the configuration of a swap file.
+type SwapFile struct {
+	// Size is the size of the swap file.
+	Size resource.Quantity
+	// Path is the absolute path of the swap file.
+	Path *string
+}
+
+// SwapBehavior is a swap behavior of the kubelet.
+type SwapBehavior string
+
+const (
+	// SwapBehaviorLimitedSwap allows the pods of the Burstable QoS class to use swap proportionally to their memory
+	// requests.
+	SwapBehaviorLimitedSwap SwapBehavior = "LimitedSwap"
+	// SwapBehaviorNoSwap does not allow pods to use swap.
+	SwapBehaviorNoSwap SwapBehavior = "NoSwap"
+)
+
+// NTP contains the configuration of the time synchronisation.
+type NTP struct {
+	// Daemon is the daemon which synchronises the time.
+	Daemon *NTPDaemon
+	// Servers is a list of host names or IP addresses of NTP servers.
+	Servers []string
+}
+
+// NTPDaemon is a daemon which synchronises the time.
+type NTPDaemon string
+
+const (
+	// NTPDaemonTimesyncd synchronises the time with systemd-timesyncd.
+	NTPDaemonTimesyncd NTPDaemon = "systemd-timesyncd"
+	// NTPDaemonChrony synchronises the time with chrony.
+	NTPDaemonChrony NTPDaemon = "chrony"
+)
+
+// CABundle is a PEM encoded bundle of CA certificates. Exactly one of inline content and Secret reference must be set.
+type CABundle struct {
+	// Name is the name of the bundle. It is part of the name of the file in the trust store.
+	Name string
+	// Inline is the PEM encoded bundle.
+	Inline *string
+	// SecretRef references a key of a Secret in the namespace of the OperatingSystemConfig which contains the PEM encoded
+	// bundle.
+	SecretRef *SecretKeyReference
+}
+
+// SecretKeyReference references a key of a Secret.
+type SecretKeyReference struct {
+	// Name is the name of the Secret.
+	Name string
+	// DataKey is the key in the data of the Secret.
+	DataKey string
+}
+
+// LocalStorage contains the configuration of the local storage of the nodes. The selected disks are combined into a
+// RAID0 array, which is formatted and mounted at the mount point. The directories are bind-mounted from it.
+type LocalStorage struct {
+	// Disks selects the local disks which are combined.
+	Disks DiskSelector
+	// Filesystem is the file system of the array.
+	Filesystem *Filesystem
+	// MountPoint is the absolute path where the array is mounted.
+	MountPoint *string
+	// Directories is a list of absolute paths of directories which are stored on the array.
+	Directories []string
+}
+
+// DiskSelector contains the rules which select local disks. A disk is selected if it matches all given rules and one
+// of the values of each list. Disks which are partitioned or mounted are never selected.
+type DiskSelector struct {
+	// Models is a list of disk models. Shell-style globs are supported.
+	Models []string
+	// Paths is a list of device paths. Shell-style globs are supported.
+	Paths []string
+	// MinSize is the minimum size of the disks.
+	MinSize *resource.Quantity
+	// MaxSize is the maximum size of the disks.
+	MaxSize *resource.Quantity
+}
+
+// Filesystem is a file system type.
+type Filesystem string
+
+const (
+	// FilesystemExt4 is the ext4 file system.
+	FilesystemExt4 Filesystem = "ext4"
+	// FilesystemXFS is the XFS file system.
+	FilesystemXFS Filesystem = "xfs"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OperatingSystemConfigurationStatus contains the effective configuration which the extension applied for an
+// OperatingSystemConfig.
+type OperatingSystemConfigurationStatus struct {
+	metav1.TypeMeta
+
+	// UserData contains information about the rendered user data.
+	UserData *UserDataStatus
+	// MemoryOne contains the effective configuration of MemoryOne.
+	MemoryOne *MemoryOneStatus
+	// InPlaceUpdates contains information about the in-place updates of the OS.
+	InPlaceUpdates *InPlaceUpdatesStatus
+}
+
+// UserDataStatus contains information about the rendered user data.
+type UserDataStatus struct {
+	// Format is the format of the user data.
+	Format UserDataFormat
+	// Hash is the SHA256 hash of the user data.
+	Hash string
+	// Size is the size of the user data in bytes.
+	Size int
+	// Compressed specifies whether the user data is compressed.
+	Compressed bool
+}
+
+// MemoryOneStatus contains the effective configuration of MemoryOne.
+type MemoryOneStatus struct {
+	// VsmpConfiguration contains the vSMP parameters after defaulting.
+	VsmpConfiguration map[string]string
+	// Warnings contains the warnings about the vSMP parameters of the provider config.
+	Warnings []string
+}
+
+// InPlaceUpdatesStatus contains information about the in-place updates of the OS.
+type InPlaceUpdatesStatus struct {
+	// ScriptPath is the path of the script which performs in-place updates on the nodes.
+	ScriptPath string
+	// ScriptVersion is the version of the script which performs in-place updates on the nodes.
+	ScriptVersion string
+}
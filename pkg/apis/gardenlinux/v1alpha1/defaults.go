@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_OperatingSystemConfiguration sets the defaults for the Garden Linux operating system configuration
+func SetDefaults_OperatingSystemConfiguration(obj *OperatingSystemConfiguration) {
+	if obj.NetworkReadiness == nil {
+		obj.NetworkReadiness = &NetworkReadiness{}
+	}
+}
+
+// SetDefaults_NetworkReadiness sets the defaults for the network readiness checks
+func SetDefaults_NetworkReadiness(obj *NetworkReadiness) {
+	if obj.NetworkOnline == nil {
+		obj.NetworkOnline = ptr.To(true)
+	}
+	if obj.ResolveHostname == nil {
+		obj.ResolveHostname = ptr.To(false)
+	}
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.RetryInterval == nil {
+		obj.RetryInterval = &metav1.Duration{Duration: 5 * time.Second}
+	}
+}
+
+// SetDefaults_NetworkInterface sets the defaults for the configuration of network interfaces
+func SetDefaults_NetworkInterface(obj *NetworkInterface) {
+	if obj.DHCP == nil {
+		obj.DHCP = &DHCP{}
+	}
+}
+
+// SetDefaults_DHCP sets the defaults for the DHCP configuration of network interfaces
+func SetDefaults_DHCP(obj *DHCP) {
+	if obj.Mode == nil {
+		obj.Mode = ptr.To(DHCPModeYes)
+	}
+}
+
+// SetDefaults_Swap sets the defaults for the swap configuration
+func SetDefaults_Swap(obj *Swap) {
+	if obj.Behavior == nil {
+		obj.Behavior = ptr.To(SwapBehaviorLimitedSwap)
+	}
+}
+
+// SetDefaults_Zram sets the defaults for the configuration of zram devices
+func SetDefaults_Zram(obj *Zram) {
+	if obj.CompressionAlgorithm == nil {
+		obj.CompressionAlgorithm = ptr.To("zstd")
+	}
+}
+
+// SetDefaults_SwapFile sets the defaults for the configuration of swap files
+func SetDefaults_SwapFile(obj *SwapFile) {
+	if obj.Path == nil {
+		obj.Path = ptr.To("/var/lib/swapfile")
+	}
+}
+
+// SetDefaults_NTP sets the defaults for the time synchronisation
+func SetDefaults_NTP(obj *NTP) {
+	if obj.Daemon == nil {
+		obj.Daemon = ptr.To(NTPDaemonTimesyncd)
+	}
+}
+
+// SetDefaults_LocalStorage sets the defaults for the local storage
+func SetDefaults_LocalStorage(obj *LocalStorage) {
+	if obj.Filesystem == nil {
+		obj.Filesystem = ptr.To(FilesystemExt4)
+	}
+	if obj.MountPoint == nil {
+		obj.MountPoint = ptr.To("/mnt/local-storage")
+	}
+	if obj.Directories == nil {
+		obj.Directories = []string{"/var/lib/containerd", "/var/lib/kubelet"}
+	}
+}
@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux
+// +k8s:openapi-gen=true
+// +k8s:defaulter-gen=TypeMeta
+
+//go:generate gen-crd-api-reference-docs -api-dir . -config ../../../../hack/api-reference/gardenlinux.json -template-dir $GARDENER_HACK_DIR/api-reference/template -out-file ../../../../hack/api-reference/gardenlinux.md
+
+// Package v1alpha1 contains the v1alpha1 version of the API.
+// +groupName=gardenlinux.os.extensions.gardener.cloud
+package v1alpha1 // import "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/v1alpha1"
@@ -0,0 +1,394 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OperatingSystemConfiguration allows to specify configuration for Garden Linux nodes of a worker pool.
+type OperatingSystemConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// KernelModules configures the kernel modules which are loaded or blacklisted on the nodes.
+	// If not present, the default kernel modules of the extension are loaded, i.e. `nfsd` unless configured otherwise.
+	// +optional
+	KernelModules *KernelModules `json:"kernelModules,omitempty"`
+	// Sysctls configures the kernel parameters which are set on the nodes.
+	// +optional
+	Sysctls *Sysctls `json:"sysctls,omitempty"`
+	// UserDataFormat is the format of the user data which provisions the nodes. Supported formats are `bash`,
+	// `cloud-config`, `mime-multipart` and `ignition`. If not present, the default format configured for the extension is used.
+	// +optional
+	UserDataFormat *UserDataFormat `json:"userDataFormat,omitempty"`
+	// Containerd configures containerd on the nodes.
+	// +optional
+	Containerd *Containerd `json:"containerd,omitempty"`
+	// NetworkReadiness configures the checks which must succeed before the nodes are provisioned.
+	// If not present, the nodes wait up to 5 minutes for `network-online.target`.
+	// +optional
+	NetworkReadiness *NetworkReadiness `json:"networkReadiness,omitempty"`
+	// Network configures systemd-networkd on the nodes.
+	// +optional
+	Network *Network `json:"network,omitempty"`
+	// Swap configures swap space on the nodes. If present, the kubelet is configured to tolerate swap.
+	// +optional
+	Swap *Swap `json:"swap,omitempty"`
+	// NTP configures the time synchronisation of the nodes. If not present, the default configuration of the machine
+	// image is used.
+	// +optional
+	NTP *NTP `json:"ntp,omitempty"`
+	// CABundles is a list of PEM encoded CA certificate bundles which are added to the trust store of the nodes before
+	// containerd is started.
+	// +optional
+	CABundles []CABundle `json:"caBundles,omitempty"`
+	// LocalStorage configures a RAID0 array of the local disks of the nodes which stores the data of containerd and the
+	// kubelet. It is set up before containerd is started.
+	// +optional
+	LocalStorage *LocalStorage `json:"localStorage,omitempty"`
+}
+
+// KernelModules contains the kernel modules to load and to blacklist.
+type KernelModules struct {
+	// Load is a list of kernel modules which are loaded during boot.
+	// +optional
+	Load []string `json:"load,omitempty"`
+	// Blacklist is a list of kernel modules which must not be loaded automatically.
+	// +optional
+	Blacklist []string `json:"blacklist,omitempty"`
+}
+
+// Sysctls contains the kernel parameters to set.
+type Sysctls struct {
+	// Profiles is a list of built-in profiles whose kernel parameters are set. Profiles listed later take precedence.
+	// +optional
+	Profiles []SysctlProfile `json:"profiles,omitempty"`
+	// Parameters is a map of kernel parameters and their values. They take precedence over the parameters of the profiles.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// SysctlProfile is the name of a built-in set of kernel parameters.
+type SysctlProfile string
+
+const (
+	// SysctlProfileHighConnectionCount tunes the network stack for nodes handling a high number of concurrent connections.
+	SysctlProfileHighConnectionCount SysctlProfile = "high-connection-count"
+	// SysctlProfileElasticsearch raises the limits required by Elasticsearch and other heavy mmap users.
+	SysctlProfileElasticsearch SysctlProfile = "elasticsearch"
+)
+
+// UserDataFormat is a format of the user data which provisions the nodes.
+type UserDataFormat string
+
+const (
+	// UserDataFormatBash renders the user data as bash script.
+	UserDataFormatBash UserDataFormat = "bash"
+	// UserDataFormatCloudConfig renders the user data as cloud-init `#cloud-config` document.
+	UserDataFormatCloudConfig UserDataFormat = "cloud-config"
+	// UserDataFormatMIMEMultipart renders the user data as MIME multipart document containing a cloud-init
+	// `#cloud-config` part which writes the files and a shell script part which runs the provisioning commands.
+	UserDataFormatMIMEMultipart UserDataFormat = "mime-multipart"
+	// UserDataFormatIgnition renders the user data as Ignition (spec v3) config.
+	UserDataFormatIgnition UserDataFormat = "ignition"
+)
+
+// Containerd contains the configuration of containerd.
+type Containerd struct {
+	// MajorVersion is the major version of containerd which is installed on the machine image, either `1` or `2`.
+	// If not present, it is detected from the machine image version of the worker pool.
+	// +optional
+	MajorVersion *int32 `json:"majorVersion,omitempty"`
+}
+
+// NetworkReadiness contains the checks which must succeed before the nodes are provisioned. The checks are retried
+// until all of them succeed or the timeout expires, in which case the provisioning fails.
+type NetworkReadiness struct {
+	// NetworkOnline specifies whether `network-online.target` must be active. Defaults to `true`.
+	// +optional
+	NetworkOnline *bool `json:"networkOnline,omitempty"`
+	// ResolveHostname specifies whether the hostname of the node must be resolvable. Defaults to `false`, in which case
+	// systemd-networkd is restarted once if the hostname cannot be resolved but the provisioning does not fail.
+	// +optional
+	ResolveHostname *bool `json:"resolveHostname,omitempty"`
+	// Endpoints is a list of TCP endpoints in the form `host:port` which must be reachable.
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty"`
+	// Timeout is the duration after which the provisioning fails if the checks did not succeed. Defaults to `5m`.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// RetryInterval is the duration between two attempts of the checks. Defaults to `5s`.
+	// +optional
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty"`
+}
+
+// Network contains the configuration of systemd-networkd.
+type Network struct {
+	// Interfaces is a list of configurations of network interfaces. Each configuration is written to a separate
+	// `.network` file which takes precedence over the default configuration of the matched interfaces.
+	// +optional
+	Interfaces []NetworkInterface `json:"interfaces,omitempty"`
+}
+
+// NetworkInterface contains the configuration of the network interfaces matched by its match rules.
+type NetworkInterface struct {
+	// Name is the name of the configuration. It is part of the name of the `.network` file.
+	Name string `json:"name"`
+	// Match selects the network interfaces which are configured.
+	Match NetworkMatch `json:"match"`
+	// MTU is the maximum transmission unit of the interfaces in bytes.
+	// +optional
+	MTU *int32 `json:"mtu,omitempty"`
+	// DHCP configures DHCP on the interfaces. If not present, DHCP is enabled for IPv4 and IPv6.
+	// +optional
+	DHCP *DHCP `json:"dhcp,omitempty"`
+	// Addresses is a list of static addresses of the interfaces in CIDR notation.
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+	// Routes is a list of static routes which are added for the interfaces.
+	// +optional
+	Routes []Route `json:"routes,omitempty"`
+}
+
+// NetworkMatch contains the rules which select network interfaces. An interface is selected if it matches all given
+// rules and one of the values of each rule. At least one rule must be given.
+type NetworkMatch struct {
+	// Names is a list of interface names. Shell-style globs are supported.
+	// +optional
+	Names []string `json:"names,omitempty"`
+	// MACAddresses is a list of hardware addresses.
+	// +optional
+	MACAddresses []string `json:"macAddresses,omitempty"`
+	// Drivers is a list of driver names. Shell-style globs are supported.
+	// +optional
+	Drivers []string `json:"drivers,omitempty"`
+}
+
+// DHCP contains the DHCP configuration of network interfaces.
+type DHCP struct {
+	// Mode selects the IP versions for which DHCP is enabled, one of `yes`, `no`, `ipv4` or `ipv6`. Defaults to `yes`.
+	// +optional
+	Mode *DHCPMode `json:"mode,omitempty"`
+	// UseDNS specifies whether the DNS servers received via DHCP are used. Defaults to the default of systemd-networkd.
+	// +optional
+	UseDNS *bool `json:"useDNS,omitempty"`
+	// UseMTU specifies whether the MTU received via DHCPv4 is used. Defaults to the default of systemd-networkd.
+	// +optional
+	UseMTU *bool `json:"useMTU,omitempty"`
+	// RouteMetric is the metric of the routes received via DHCPv4.
+	// +optional
+	RouteMetric *int32 `json:"routeMetric,omitempty"`
+}
+
+// DHCPMode is a mode of DHCP.
+type DHCPMode string
+
+const (
+	// DHCPModeYes enables DHCP for IPv4 and IPv6.
+	DHCPModeYes DHCPMode = "yes"
+	// DHCPModeNo disables DHCP.
+	DHCPModeNo DHCPMode = "no"
+	// DHCPModeIPv4 enables DHCP for IPv4 only.
+	DHCPModeIPv4 DHCPMode = "ipv4"
+	// DHCPModeIPv6 enables DHCP for IPv6 only.
+	DHCPModeIPv6 DHCPMode = "ipv6"
+)
+
+// Route is a static route.
+type Route struct {
+	// Destination is the destination network of the route in CIDR notation.
+	Destination string `json:"destination"`
+	// Gateway is the address of the gateway of the route.
+	// +optional
+	Gateway *string `json:"gateway,omitempty"`
+	// Metric is the metric of the route.
+	// +optional
+	Metric *int32 `json:"metric,omitempty"`
+}
+
+// Swap contains the configuration of swap space. Exactly one of zram device and swap file must be configured.
+type Swap struct {
+	// Zram configures a compressed swap device in memory.
+	// +optional
+	Zram *Zram `json:"zram,omitempty"`
+	// File configures a swap file on the disk.
+	// +optional
+	File *SwapFile `json:"file,omitempty"`
+	// Behavior is the swap behavior of the kubelet for the pods on the nodes, either `LimitedSwap` or `NoSwap`.
+	// Defaults to `LimitedSwap`.
+	// +optional
+	Behavior *SwapBehavior `json:"behavior,omitempty"`
+}
+
+// Zram contains the configuration of a compressed swap device in memory.
+type Zram struct {
+	// Size is the size of the uncompressed data which the device can hold.
+	Size resource.Quantity `json:"size"`
+	// CompressionAlgorithm is the compression algorithm of the device. Defaults to `zstd`.
+	// +optional
+	CompressionAlgorithm *string `json:"compressionAlgorithm,omitempty"`
+}
+
+// SwapFile contains the configuration of a swap file.
+type SwapFile struct {
+	// Size is the size of the swap file.
+	Size resource.Quantity `json:"size"`
+	// Path is the absolute path of the swap file. Defaults to `/var/lib/swapfile`.
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
+// SwapBehavior is a swap behavior of the kubelet.
+type SwapBehavior string
+
+const (
+	// SwapBehaviorLimitedSwap allows the pods of the Burstable QoS class to use swap proportionally to their memory
+	// requests.
+	SwapBehaviorLimitedSwap SwapBehavior = "LimitedSwap"
+	// SwapBehaviorNoSwap does not allow pods to use swap.
+	SwapBehaviorNoSwap SwapBehavior = "NoSwap"
+)
+
+// NTP contains the configuration of the time synchronisation.
+type NTP struct {
+	// Daemon is the daemon which synchronises the time, either `systemd-timesyncd` or `chrony`. The other daemon is
+	// disabled. Defaults to `systemd-timesyncd`.
+	// +optional
+	Daemon *NTPDaemon `json:"daemon,omitempty"`
+	// Servers is a list of host names or IP addresses of NTP servers.
+	Servers []string `json:"servers"`
+}
+
+// NTPDaemon is a daemon which synchronises the time.
+type NTPDaemon string
+
+const (
+	// NTPDaemonTimesyncd synchronises the time with systemd-timesyncd.
+	NTPDaemonTimesyncd NTPDaemon = "systemd-timesyncd"
+	// NTPDaemonChrony synchronises the time with chrony.
+	NTPDaemonChrony NTPDaemon = "chrony"
+)
+
+// CABundle is a PEM encoded bundle of CA certificates. Exactly one of inline content and Secret reference must be set.
+type CABundle struct {
+	// Name is the name of the bundle. It is part of the name of the file in the trust store.
+	Name string `json:"name"`
+	// Inline is the PEM encoded bundle.
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+	// SecretRef references a key of a Secret in the namespace of the OperatingSystemConfig which contains the PEM encoded
+	// bundle.
+	// +optional
+	SecretRef *SecretKeyReference `json:"secretRef,omitempty"`
+}
+
+// SecretKeyReference references a key of a Secret.
+type SecretKeyReference struct {
+	// Name is the name of the Secret.
+	Name string `json:"name"`
+	// DataKey is the key in the data of the Secret.
+	DataKey string `json:"dataKey"`
+}
+
+// LocalStorage contains the configuration of the local storage of the nodes. The selected disks are combined into a
+// RAID0 array, which is formatted and mounted at the mount point. The directories are bind-mounted from it.
+type LocalStorage struct {
+	// Disks selects the local disks which are combined.
+	Disks DiskSelector `json:"disks"`
+	// Filesystem is the file system of the array, either `ext4` or `xfs`. Defaults to `ext4`.
+	// +optional
+	Filesystem *Filesystem `json:"filesystem,omitempty"`
+	// MountPoint is the absolute path where the array is mounted. Defaults to `/mnt/local-storage`.
+	// +optional
+	MountPoint *string `json:"mountPoint,omitempty"`
+	// Directories is a list of absolute paths of directories which are stored on the array. Defaults to
+	// `/var/lib/containerd` and `/var/lib/kubelet`.
+	// +optional
+	Directories []string `json:"directories,omitempty"`
+}
+
+// DiskSelector contains the rules which select local disks. A disk is selected if it matches all given rules and one
+// of the values of each list. Disks which are partitioned or mounted are never selected.
+type DiskSelector struct {
+	// Models is a list of disk models, e.g. `Amazon EC2 NVMe Instance Storage`. Shell-style globs are supported.
+	// +optional
+	Models []string `json:"models,omitempty"`
+	// Paths is a list of device paths, e.g. `/dev/nvme*n1`. Shell-style globs are supported.
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+	// MinSize is the minimum size of the disks.
+	// +optional
+	MinSize *resource.Quantity `json:"minSize,omitempty"`
+	// MaxSize is the maximum size of the disks.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
+// Filesystem is a file system type.
+type Filesystem string
+
+const (
+	// FilesystemExt4 is the ext4 file system.
+	FilesystemExt4 Filesystem = "ext4"
+	// FilesystemXFS is the XFS file system.
+	FilesystemXFS Filesystem = "xfs"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OperatingSystemConfigurationStatus contains the effective configuration which the extension applied for an
+// OperatingSystemConfig. It is published in the `status.providerStatus` of the OperatingSystemConfig.
+type OperatingSystemConfigurationStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// UserData contains information about the rendered user data. It is only present for the purpose `provision`.
+	// +optional
+	UserData *UserDataStatus `json:"userData,omitempty"`
+	// MemoryOne contains the effective configuration of MemoryOne. It is only present for the OS type
+	// `memoryone-gardenlinux` and the purpose `provision`.
+	// +optional
+	MemoryOne *MemoryOneStatus `json:"memoryOne,omitempty"`
+	// InPlaceUpdates contains information about the in-place updates of the OS. It is only present for the purpose
+	// `reconcile` if in-place updates are requested.
+	// +optional
+	InPlaceUpdates *InPlaceUpdatesStatus `json:"inPlaceUpdates,omitempty"`
+}
+
+// UserDataStatus contains information about the rendered user data.
+type UserDataStatus struct {
+	// Format is the format of the user data.
+	Format UserDataFormat `json:"format"`
+	// Hash is the SHA256 hash of the user data.
+	Hash string `json:"hash"`
+	// Size is the size of the user data in bytes.
+	Size int `json:"size"`
+	// Compressed specifies whether the user data is compressed because it exceeds the user data size limit.
+	// +optional
+	Compressed bool `json:"compressed,omitempty"`
+}
+
+// MemoryOneStatus contains the effective configuration of MemoryOne.
+type MemoryOneStatus struct {
+	// VsmpConfiguration contains the vSMP parameters after defaulting, including `mem_topology` and `system_memory`.
+	VsmpConfiguration map[string]string `json:"vsmpConfiguration"`
+	// Warnings contains the warnings about the vSMP parameters of the provider config which are accepted but may not
+	// have the intended effect, i.e. unknown parameters which are passed through to vSMP without validation and
+	// `mem_topology` or `system_memory` in `vsmpConfiguration` which are overridden by the dedicated fields.
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// InPlaceUpdatesStatus contains information about the in-place updates of the OS.
+type InPlaceUpdatesStatus struct {
+	// ScriptPath is the path of the script which performs in-place updates on the nodes.
+	ScriptPath string `json:"scriptPath"`
+	// ScriptVersion is the version of the script which performs in-place updates on the nodes, i.e. the SHA256 hash of
+	// its content.
+	ScriptVersion string `json:"scriptVersion"`
+}
@@ -0,0 +1,794 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	gardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CABundle)(nil), (*gardenlinux.CABundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CABundle_To_gardenlinux_CABundle(a.(*CABundle), b.(*gardenlinux.CABundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.CABundle)(nil), (*CABundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_CABundle_To_v1alpha1_CABundle(a.(*gardenlinux.CABundle), b.(*CABundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Containerd)(nil), (*gardenlinux.Containerd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Containerd_To_gardenlinux_Containerd(a.(*Containerd), b.(*gardenlinux.Containerd), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.Containerd)(nil), (*Containerd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_Containerd_To_v1alpha1_Containerd(a.(*gardenlinux.Containerd), b.(*Containerd), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DHCP)(nil), (*gardenlinux.DHCP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DHCP_To_gardenlinux_DHCP(a.(*DHCP), b.(*gardenlinux.DHCP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.DHCP)(nil), (*DHCP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_DHCP_To_v1alpha1_DHCP(a.(*gardenlinux.DHCP), b.(*DHCP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskSelector)(nil), (*gardenlinux.DiskSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskSelector_To_gardenlinux_DiskSelector(a.(*DiskSelector), b.(*gardenlinux.DiskSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.DiskSelector)(nil), (*DiskSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_DiskSelector_To_v1alpha1_DiskSelector(a.(*gardenlinux.DiskSelector), b.(*DiskSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InPlaceUpdatesStatus)(nil), (*gardenlinux.InPlaceUpdatesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InPlaceUpdatesStatus_To_gardenlinux_InPlaceUpdatesStatus(a.(*InPlaceUpdatesStatus), b.(*gardenlinux.InPlaceUpdatesStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.InPlaceUpdatesStatus)(nil), (*InPlaceUpdatesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_InPlaceUpdatesStatus_To_v1alpha1_InPlaceUpdatesStatus(a.(*gardenlinux.InPlaceUpdatesStatus), b.(*InPlaceUpdatesStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KernelModules)(nil), (*gardenlinux.KernelModules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KernelModules_To_gardenlinux_KernelModules(a.(*KernelModules), b.(*gardenlinux.KernelModules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.KernelModules)(nil), (*KernelModules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_KernelModules_To_v1alpha1_KernelModules(a.(*gardenlinux.KernelModules), b.(*KernelModules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LocalStorage)(nil), (*gardenlinux.LocalStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LocalStorage_To_gardenlinux_LocalStorage(a.(*LocalStorage), b.(*gardenlinux.LocalStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.LocalStorage)(nil), (*LocalStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_LocalStorage_To_v1alpha1_LocalStorage(a.(*gardenlinux.LocalStorage), b.(*LocalStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MemoryOneStatus)(nil), (*gardenlinux.MemoryOneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MemoryOneStatus_To_gardenlinux_MemoryOneStatus(a.(*MemoryOneStatus), b.(*gardenlinux.MemoryOneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.MemoryOneStatus)(nil), (*MemoryOneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_MemoryOneStatus_To_v1alpha1_MemoryOneStatus(a.(*gardenlinux.MemoryOneStatus), b.(*MemoryOneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NTP)(nil), (*gardenlinux.NTP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NTP_To_gardenlinux_NTP(a.(*NTP), b.(*gardenlinux.NTP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.NTP)(nil), (*NTP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_NTP_To_v1alpha1_NTP(a.(*gardenlinux.NTP), b.(*NTP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Network)(nil), (*gardenlinux.Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Network_To_gardenlinux_Network(a.(*Network), b.(*gardenlinux.Network), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.Network)(nil), (*Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_Network_To_v1alpha1_Network(a.(*gardenlinux.Network), b.(*Network), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterface)(nil), (*gardenlinux.NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterface_To_gardenlinux_NetworkInterface(a.(*NetworkInterface), b.(*gardenlinux.NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.NetworkInterface)(nil), (*NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_NetworkInterface_To_v1alpha1_NetworkInterface(a.(*gardenlinux.NetworkInterface), b.(*NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkMatch)(nil), (*gardenlinux.NetworkMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkMatch_To_gardenlinux_NetworkMatch(a.(*NetworkMatch), b.(*gardenlinux.NetworkMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.NetworkMatch)(nil), (*NetworkMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_NetworkMatch_To_v1alpha1_NetworkMatch(a.(*gardenlinux.NetworkMatch), b.(*NetworkMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkReadiness)(nil), (*gardenlinux.NetworkReadiness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkReadiness_To_gardenlinux_NetworkReadiness(a.(*NetworkReadiness), b.(*gardenlinux.NetworkReadiness), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.NetworkReadiness)(nil), (*NetworkReadiness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_NetworkReadiness_To_v1alpha1_NetworkReadiness(a.(*gardenlinux.NetworkReadiness), b.(*NetworkReadiness), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OperatingSystemConfiguration)(nil), (*gardenlinux.OperatingSystemConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperatingSystemConfiguration_To_gardenlinux_OperatingSystemConfiguration(a.(*OperatingSystemConfiguration), b.(*gardenlinux.OperatingSystemConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.OperatingSystemConfiguration)(nil), (*OperatingSystemConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_OperatingSystemConfiguration_To_v1alpha1_OperatingSystemConfiguration(a.(*gardenlinux.OperatingSystemConfiguration), b.(*OperatingSystemConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OperatingSystemConfigurationStatus)(nil), (*gardenlinux.OperatingSystemConfigurationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperatingSystemConfigurationStatus_To_gardenlinux_OperatingSystemConfigurationStatus(a.(*OperatingSystemConfigurationStatus), b.(*gardenlinux.OperatingSystemConfigurationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.OperatingSystemConfigurationStatus)(nil), (*OperatingSystemConfigurationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_OperatingSystemConfigurationStatus_To_v1alpha1_OperatingSystemConfigurationStatus(a.(*gardenlinux.OperatingSystemConfigurationStatus), b.(*OperatingSystemConfigurationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Route)(nil), (*gardenlinux.Route)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Route_To_gardenlinux_Route(a.(*Route), b.(*gardenlinux.Route), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.Route)(nil), (*Route)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_Route_To_v1alpha1_Route(a.(*gardenlinux.Route), b.(*Route), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretKeyReference)(nil), (*gardenlinux.SecretKeyReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretKeyReference_To_gardenlinux_SecretKeyReference(a.(*SecretKeyReference), b.(*gardenlinux.SecretKeyReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.SecretKeyReference)(nil), (*SecretKeyReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_SecretKeyReference_To_v1alpha1_SecretKeyReference(a.(*gardenlinux.SecretKeyReference), b.(*SecretKeyReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Swap)(nil), (*gardenlinux.Swap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Swap_To_gardenlinux_Swap(a.(*Swap), b.(*gardenlinux.Swap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.Swap)(nil), (*Swap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_Swap_To_v1alpha1_Swap(a.(*gardenlinux.Swap), b.(*Swap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SwapFile)(nil), (*gardenlinux.SwapFile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SwapFile_To_gardenlinux_SwapFile(a.(*SwapFile), b.(*gardenlinux.SwapFile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.SwapFile)(nil), (*SwapFile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_SwapFile_To_v1alpha1_SwapFile(a.(*gardenlinux.SwapFile), b.(*SwapFile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Sysctls)(nil), (*gardenlinux.Sysctls)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Sysctls_To_gardenlinux_Sysctls(a.(*Sysctls), b.(*gardenlinux.Sysctls), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.Sysctls)(nil), (*Sysctls)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_Sysctls_To_v1alpha1_Sysctls(a.(*gardenlinux.Sysctls), b.(*Sysctls), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UserDataStatus)(nil), (*gardenlinux.UserDataStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UserDataStatus_To_gardenlinux_UserDataStatus(a.(*UserDataStatus), b.(*gardenlinux.UserDataStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.UserDataStatus)(nil), (*UserDataStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_UserDataStatus_To_v1alpha1_UserDataStatus(a.(*gardenlinux.UserDataStatus), b.(*UserDataStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Zram)(nil), (*gardenlinux.Zram)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Zram_To_gardenlinux_Zram(a.(*Zram), b.(*gardenlinux.Zram), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gardenlinux.Zram)(nil), (*Zram)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gardenlinux_Zram_To_v1alpha1_Zram(a.(*gardenlinux.Zram), b.(*Zram), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_CABundle_To_gardenlinux_CABundle(in *CABundle, out *gardenlinux.CABundle, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Inline = (*string)(unsafe.Pointer(in.Inline))
+	out.SecretRef = (*gardenlinux.SecretKeyReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_CABundle_To_gardenlinux_CABundle is an autogenerated conversion function.
+func Convert_v1alpha1_CABundle_To_gardenlinux_CABundle(in *CABundle, out *gardenlinux.CABundle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CABundle_To_gardenlinux_CABundle(in, out, s)
+}
+
+func autoConvert_gardenlinux_CABundle_To_v1alpha1_CABundle(in *gardenlinux.CABundle, out *CABundle, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Inline = (*string)(unsafe.Pointer(in.Inline))
+	out.SecretRef = (*SecretKeyReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_gardenlinux_CABundle_To_v1alpha1_CABundle is an autogenerated conversion function.
+func Convert_gardenlinux_CABundle_To_v1alpha1_CABundle(in *gardenlinux.CABundle, out *CABundle, s conversion.Scope) error {
+	return autoConvert_gardenlinux_CABundle_To_v1alpha1_CABundle(in, out, s)
+}
+
+func autoConvert_v1alpha1_Containerd_To_gardenlinux_Containerd(in *Containerd, out *gardenlinux.Containerd, s conversion.Scope) error {
+	out.MajorVersion = (*int32)(unsafe.Pointer(in.MajorVersion))
+	return nil
+}
+
+// Convert_v1alpha1_Containerd_To_gardenlinux_Containerd is an autogenerated conversion function.
+func Convert_v1alpha1_Containerd_To_gardenlinux_Containerd(in *Containerd, out *gardenlinux.Containerd, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Containerd_To_gardenlinux_Containerd(in, out, s)
+}
+
+func autoConvert_gardenlinux_Containerd_To_v1alpha1_Containerd(in *gardenlinux.Containerd, out *Containerd, s conversion.Scope) error {
+	out.MajorVersion = (*int32)(unsafe.Pointer(in.MajorVersion))
+	return nil
+}
+
+// Convert_gardenlinux_Containerd_To_v1alpha1_Containerd is an autogenerated conversion function.
+func Convert_gardenlinux_Containerd_To_v1alpha1_Containerd(in *gardenlinux.Containerd, out *Containerd, s conversion.Scope) error {
+	return autoConvert_gardenlinux_Containerd_To_v1alpha1_Containerd(in, out, s)
+}
+
+func autoConvert_v1alpha1_DHCP_To_gardenlinux_DHCP(in *DHCP, out *gardenlinux.DHCP, s conversion.Scope) error {
+	out.Mode = (*gardenlinux.DHCPMode)(unsafe.Pointer(in.Mode))
+	out.UseDNS = (*bool)(unsafe.Pointer(in.UseDNS))
+	out.UseMTU = (*bool)(unsafe.Pointer(in.UseMTU))
+	out.RouteMetric = (*int32)(unsafe.Pointer(in.RouteMetric))
+	return nil
+}
+
+// Convert_v1alpha1_DHCP_To_gardenlinux_DHCP is an autogenerated conversion function.
+func Convert_v1alpha1_DHCP_To_gardenlinux_DHCP(in *DHCP, out *gardenlinux.DHCP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DHCP_To_gardenlinux_DHCP(in, out, s)
+}
+
+func autoConvert_gardenlinux_DHCP_To_v1alpha1_DHCP(in *gardenlinux.DHCP, out *DHCP, s conversion.Scope) error {
+	out.Mode = (*DHCPMode)(unsafe.Pointer(in.Mode))
+	out.UseDNS = (*bool)(unsafe.Pointer(in.UseDNS))
+	out.UseMTU = (*bool)(unsafe.Pointer(in.UseMTU))
+	out.RouteMetric = (*int32)(unsafe.Pointer(in.RouteMetric))
+	return nil
+}
+
+// Convert_gardenlinux_DHCP_To_v1alpha1_DHCP is an autogenerated conversion function.
+func Convert_gardenlinux_DHCP_To_v1alpha1_DHCP(in *gardenlinux.DHCP, out *DHCP, s conversion.Scope) error {
+	return autoConvert_gardenlinux_DHCP_To_v1alpha1_DHCP(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskSelector_To_gardenlinux_DiskSelector(in *DiskSelector, out *gardenlinux.DiskSelector, s conversion.Scope) error {
+	out.Models = *(*[]string)(unsafe.Pointer(&in.Models))
+	out.Paths = *(*[]string)(unsafe.Pointer(&in.Paths))
+	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.MaxSize = (*resource.Quantity)(unsafe.Pointer(in.MaxSize))
+	return nil
+}
+
+// Convert_v1alpha1_DiskSelector_To_gardenlinux_DiskSelector is an autogenerated conversion function.
+func Convert_v1alpha1_DiskSelector_To_gardenlinux_DiskSelector(in *DiskSelector, out *gardenlinux.DiskSelector, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskSelector_To_gardenlinux_DiskSelector(in, out, s)
+}
+
+func autoConvert_gardenlinux_DiskSelector_To_v1alpha1_DiskSelector(in *gardenlinux.DiskSelector, out *DiskSelector, s conversion.Scope) error {
+	out.Models = *(*[]string)(unsafe.Pointer(&in.Models))
+	out.Paths = *(*[]string)(unsafe.Pointer(&in.Paths))
+	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.MaxSize = (*resource.Quantity)(unsafe.Pointer(in.MaxSize))
+	return nil
+}
+
+// Convert_gardenlinux_DiskSelector_To_v1alpha1_DiskSelector is an autogenerated conversion function.
+func Convert_gardenlinux_DiskSelector_To_v1alpha1_DiskSelector(in *gardenlinux.DiskSelector, out *DiskSelector, s conversion.Scope) error {
+	return autoConvert_gardenlinux_DiskSelector_To_v1alpha1_DiskSelector(in, out, s)
+}
+
+func autoConvert_v1alpha1_InPlaceUpdatesStatus_To_gardenlinux_InPlaceUpdatesStatus(in *InPlaceUpdatesStatus, out *gardenlinux.InPlaceUpdatesStatus, s conversion.Scope) error {
+	out.ScriptPath = in.ScriptPath
+	out.ScriptVersion = in.ScriptVersion
+	return nil
+}
+
+// Convert_v1alpha1_InPlaceUpdatesStatus_To_gardenlinux_InPlaceUpdatesStatus is an autogenerated conversion function.
+func Convert_v1alpha1_InPlaceUpdatesStatus_To_gardenlinux_InPlaceUpdatesStatus(in *InPlaceUpdatesStatus, out *gardenlinux.InPlaceUpdatesStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InPlaceUpdatesStatus_To_gardenlinux_InPlaceUpdatesStatus(in, out, s)
+}
+
+func autoConvert_gardenlinux_InPlaceUpdatesStatus_To_v1alpha1_InPlaceUpdatesStatus(in *gardenlinux.InPlaceUpdatesStatus, out *InPlaceUpdatesStatus, s conversion.Scope) error {
+	out.ScriptPath = in.ScriptPath
+	out.ScriptVersion = in.ScriptVersion
+	return nil
+}
+
+// Convert_gardenlinux_InPlaceUpdatesStatus_To_v1alpha1_InPlaceUpdatesStatus is an autogenerated conversion function.
+func Convert_gardenlinux_InPlaceUpdatesStatus_To_v1alpha1_InPlaceUpdatesStatus(in *gardenlinux.InPlaceUpdatesStatus, out *InPlaceUpdatesStatus, s conversion.Scope) error {
+	return autoConvert_gardenlinux_InPlaceUpdatesStatus_To_v1alpha1_InPlaceUpdatesStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_KernelModules_To_gardenlinux_KernelModules(in *KernelModules, out *gardenlinux.KernelModules, s conversion.Scope) error {
+	out.Load = *(*[]string)(unsafe.Pointer(&in.Load))
+	out.Blacklist = *(*[]string)(unsafe.Pointer(&in.Blacklist))
+	return nil
+}
+
+// Convert_v1alpha1_KernelModules_To_gardenlinux_KernelModules is an autogenerated conversion function.
+func Convert_v1alpha1_KernelModules_To_gardenlinux_KernelModules(in *KernelModules, out *gardenlinux.KernelModules, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KernelModules_To_gardenlinux_KernelModules(in, out, s)
+}
+
+func autoConvert_gardenlinux_KernelModules_To_v1alpha1_KernelModules(in *gardenlinux.KernelModules, out *KernelModules, s conversion.Scope) error {
+	out.Load = *(*[]string)(unsafe.Pointer(&in.Load))
+	out.Blacklist = *(*[]string)(unsafe.Pointer(&in.Blacklist))
+	return nil
+}
+
+// Convert_gardenlinux_KernelModules_To_v1alpha1_KernelModules is an autogenerated conversion function.
+func Convert_gardenlinux_KernelModules_To_v1alpha1_KernelModules(in *gardenlinux.KernelModules, out *KernelModules, s conversion.Scope) error {
+	return autoConvert_gardenlinux_KernelModules_To_v1alpha1_KernelModules(in, out, s)
+}
+
+func autoConvert_v1alpha1_LocalStorage_To_gardenlinux_LocalStorage(in *LocalStorage, out *gardenlinux.LocalStorage, s conversion.Scope) error {
+	if err := Convert_v1alpha1_DiskSelector_To_gardenlinux_DiskSelector(&in.Disks, &out.Disks, s); err != nil {
+		return err
+	}
+	out.Filesystem = (*gardenlinux.Filesystem)(unsafe.Pointer(in.Filesystem))
+	out.MountPoint = (*string)(unsafe.Pointer(in.MountPoint))
+	out.Directories = *(*[]string)(unsafe.Pointer(&in.Directories))
+	return nil
+}
+
+// Convert_v1alpha1_LocalStorage_To_gardenlinux_LocalStorage is an autogenerated conversion function.
+func Convert_v1alpha1_LocalStorage_To_gardenlinux_LocalStorage(in *LocalStorage, out *gardenlinux.LocalStorage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LocalStorage_To_gardenlinux_LocalStorage(in, out, s)
+}
+
+func autoConvert_gardenlinux_LocalStorage_To_v1alpha1_LocalStorage(in *gardenlinux.LocalStorage, out *LocalStorage, s conversion.Scope) error {
+	if err := Convert_gardenlinux_DiskSelector_To_v1alpha1_DiskSelector(&in.Disks, &out.Disks, s); err != nil {
+		return err
+	}
+	out.Filesystem = (*Filesystem)(unsafe.Pointer(in.Filesystem))
+	out.MountPoint = (*string)(unsafe.Pointer(in.MountPoint))
+	out.Directories = *(*[]string)(unsafe.Pointer(&in.Directories))
+	return nil
+}
+
+// Convert_gardenlinux_LocalStorage_To_v1alpha1_LocalStorage is an autogenerated conversion function.
+func Convert_gardenlinux_LocalStorage_To_v1alpha1_LocalStorage(in *gardenlinux.LocalStorage, out *LocalStorage, s conversion.Scope) error {
+	return autoConvert_gardenlinux_LocalStorage_To_v1alpha1_LocalStorage(in, out, s)
+}
+
+func autoConvert_v1alpha1_MemoryOneStatus_To_gardenlinux_MemoryOneStatus(in *MemoryOneStatus, out *gardenlinux.MemoryOneStatus, s conversion.Scope) error {
+	out.VsmpConfiguration = *(*map[string]string)(unsafe.Pointer(&in.VsmpConfiguration))
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_v1alpha1_MemoryOneStatus_To_gardenlinux_MemoryOneStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MemoryOneStatus_To_gardenlinux_MemoryOneStatus(in *MemoryOneStatus, out *gardenlinux.MemoryOneStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MemoryOneStatus_To_gardenlinux_MemoryOneStatus(in, out, s)
+}
+
+func autoConvert_gardenlinux_MemoryOneStatus_To_v1alpha1_MemoryOneStatus(in *gardenlinux.MemoryOneStatus, out *MemoryOneStatus, s conversion.Scope) error {
+	out.VsmpConfiguration = *(*map[string]string)(unsafe.Pointer(&in.VsmpConfiguration))
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_gardenlinux_MemoryOneStatus_To_v1alpha1_MemoryOneStatus is an autogenerated conversion function.
+func Convert_gardenlinux_MemoryOneStatus_To_v1alpha1_MemoryOneStatus(in *gardenlinux.MemoryOneStatus, out *MemoryOneStatus, s conversion.Scope) error {
+	return autoConvert_gardenlinux_MemoryOneStatus_To_v1alpha1_MemoryOneStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_NTP_To_gardenlinux_NTP(in *NTP, out *gardenlinux.NTP, s conversion.Scope) error {
+	out.Daemon = (*gardenlinux.NTPDaemon)(unsafe.Pointer(in.Daemon))
+	out.Servers = *(*[]string)(unsafe.Pointer(&in.Servers))
+	return nil
+}
+
+// Convert_v1alpha1_NTP_To_gardenlinux_NTP is an autogenerated conversion function.
+func Convert_v1alpha1_NTP_To_gardenlinux_NTP(in *NTP, out *gardenlinux.NTP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NTP_To_gardenlinux_NTP(in, out, s)
+}
+
+func autoConvert_gardenlinux_NTP_To_v1alpha1_NTP(in *gardenlinux.NTP, out *NTP, s conversion.Scope) error {
+	out.Daemon = (*NTPDaemon)(unsafe.Pointer(in.Daemon))
+	out.Servers = *(*[]string)(unsafe.Pointer(&in.Servers))
+	return nil
+}
+
+// Convert_gardenlinux_NTP_To_v1alpha1_NTP is an autogenerated conversion function.
+func Convert_gardenlinux_NTP_To_v1alpha1_NTP(in *gardenlinux.NTP, out *NTP, s conversion.Scope) error {
+	return autoConvert_gardenlinux_NTP_To_v1alpha1_NTP(in, out, s)
+}
+
+func autoConvert_v1alpha1_Network_To_gardenlinux_Network(in *Network, out *gardenlinux.Network, s conversion.Scope) error {
+	out.Interfaces = *(*[]gardenlinux.NetworkInterface)(unsafe.Pointer(&in.Interfaces))
+	return nil
+}
+
+// Convert_v1alpha1_Network_To_gardenlinux_Network is an autogenerated conversion function.
+func Convert_v1alpha1_Network_To_gardenlinux_Network(in *Network, out *gardenlinux.Network, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Network_To_gardenlinux_Network(in, out, s)
+}
+
+func autoConvert_gardenlinux_Network_To_v1alpha1_Network(in *gardenlinux.Network, out *Network, s conversion.Scope) error {
+	out.Interfaces = *(*[]NetworkInterface)(unsafe.Pointer(&in.Interfaces))
+	return nil
+}
+
+// Convert_gardenlinux_Network_To_v1alpha1_Network is an autogenerated conversion function.
+func Convert_gardenlinux_Network_To_v1alpha1_Network(in *gardenlinux.Network, out *Network, s conversion.Scope) error {
+	return autoConvert_gardenlinux_Network_To_v1alpha1_Network(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterface_To_gardenlinux_NetworkInterface(in *NetworkInterface, out *gardenlinux.NetworkInterface, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_NetworkMatch_To_gardenlinux_NetworkMatch(&in.Match, &out.Match, s); err != nil {
+		return err
+	}
+	out.MTU = (*int32)(unsafe.Pointer(in.MTU))
+	out.DHCP = (*gardenlinux.DHCP)(unsafe.Pointer(in.DHCP))
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.Routes = *(*[]gardenlinux.Route)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterface_To_gardenlinux_NetworkInterface is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterface_To_gardenlinux_NetworkInterface(in *NetworkInterface, out *gardenlinux.NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterface_To_gardenlinux_NetworkInterface(in, out, s)
+}
+
+func autoConvert_gardenlinux_NetworkInterface_To_v1alpha1_NetworkInterface(in *gardenlinux.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_gardenlinux_NetworkMatch_To_v1alpha1_NetworkMatch(&in.Match, &out.Match, s); err != nil {
+		return err
+	}
+	out.MTU = (*int32)(unsafe.Pointer(in.MTU))
+	out.DHCP = (*DHCP)(unsafe.Pointer(in.DHCP))
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.Routes = *(*[]Route)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_gardenlinux_NetworkInterface_To_v1alpha1_NetworkInterface is an autogenerated conversion function.
+func Convert_gardenlinux_NetworkInterface_To_v1alpha1_NetworkInterface(in *gardenlinux.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	return autoConvert_gardenlinux_NetworkInterface_To_v1alpha1_NetworkInterface(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkMatch_To_gardenlinux_NetworkMatch(in *NetworkMatch, out *gardenlinux.NetworkMatch, s conversion.Scope) error {
+	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	out.MACAddresses = *(*[]string)(unsafe.Pointer(&in.MACAddresses))
+	out.Drivers = *(*[]string)(unsafe.Pointer(&in.Drivers))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkMatch_To_gardenlinux_NetworkMatch is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkMatch_To_gardenlinux_NetworkMatch(in *NetworkMatch, out *gardenlinux.NetworkMatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkMatch_To_gardenlinux_NetworkMatch(in, out, s)
+}
+
+func autoConvert_gardenlinux_NetworkMatch_To_v1alpha1_NetworkMatch(in *gardenlinux.NetworkMatch, out *NetworkMatch, s conversion.Scope) error {
+	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	out.MACAddresses = *(*[]string)(unsafe.Pointer(&in.MACAddresses))
+	out.Drivers = *(*[]string)(unsafe.Pointer(&in.Drivers))
+	return nil
+}
+
+// Convert_gardenlinux_NetworkMatch_To_v1alpha1_NetworkMatch is an autogenerated conversion function.
+func Convert_gardenlinux_NetworkMatch_To_v1alpha1_NetworkMatch(in *gardenlinux.NetworkMatch, out *NetworkMatch, s conversion.Scope) error {
+	return autoConvert_gardenlinux_NetworkMatch_To_v1alpha1_NetworkMatch(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkReadiness_To_gardenlinux_NetworkReadiness(in *NetworkReadiness, out *gardenlinux.NetworkReadiness, s conversion.Scope) error {
+	out.NetworkOnline = (*bool)(unsafe.Pointer(in.NetworkOnline))
+	out.ResolveHostname = (*bool)(unsafe.Pointer(in.ResolveHostname))
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.RetryInterval = (*v1.Duration)(unsafe.Pointer(in.RetryInterval))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkReadiness_To_gardenlinux_NetworkReadiness is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkReadiness_To_gardenlinux_NetworkReadiness(in *NetworkReadiness, out *gardenlinux.NetworkReadiness, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkReadiness_To_gardenlinux_NetworkReadiness(in, out, s)
+}
+
+func autoConvert_gardenlinux_NetworkReadiness_To_v1alpha1_NetworkReadiness(in *gardenlinux.NetworkReadiness, out *NetworkReadiness, s conversion.Scope) error {
+	out.NetworkOnline = (*bool)(unsafe.Pointer(in.NetworkOnline))
+	out.ResolveHostname = (*bool)(unsafe.Pointer(in.ResolveHostname))
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.RetryInterval = (*v1.Duration)(unsafe.Pointer(in.RetryInterval))
+	return nil
+}
+
+// Convert_gardenlinux_NetworkReadiness_To_v1alpha1_NetworkReadiness is an autogenerated conversion function.
+func Convert_gardenlinux_NetworkReadiness_To_v1alpha1_NetworkReadiness(in *gardenlinux.NetworkReadiness, out *NetworkReadiness, s conversion.Scope) error {
+	return autoConvert_gardenlinux_NetworkReadiness_To_v1alpha1_NetworkReadiness(in, out, s)
+}
+
+func autoConvert_v1alpha1_OperatingSystemConfiguration_To_gardenlinux_OperatingSystemConfiguration(in *OperatingSystemConfiguration, out *gardenlinux.OperatingSystemConfiguration, s conversion.Scope) error {
+	out.KernelModules = (*gardenlinux.KernelModules)(unsafe.Pointer(in.KernelModules))
+	out.Sysctls = (*gardenlinux.Sysctls)(unsafe.Pointer(in.Sysctls))
+	out.UserDataFormat = (*gardenlinux.UserDataFormat)(unsafe.Pointer(in.UserDataFormat))
+	out.Containerd = (*gardenlinux.Containerd)(unsafe.Pointer(in.Containerd))
+	out.NetworkReadiness = (*gardenlinux.NetworkReadiness)(unsafe.Pointer(in.NetworkReadiness))
+	out.Network = (*gardenlinux.Network)(unsafe.Pointer(in.Network))
+	out.Swap = (*gardenlinux.Swap)(unsafe.Pointer(in.Swap))
+	out.NTP = (*gardenlinux.NTP)(unsafe.Pointer(in.NTP))
+	out.CABundles = *(*[]gardenlinux.CABundle)(unsafe.Pointer(&in.CABundles))
+	out.LocalStorage = (*gardenlinux.LocalStorage)(unsafe.Pointer(in.LocalStorage))
+	return nil
+}
+
+// Convert_v1alpha1_OperatingSystemConfiguration_To_gardenlinux_OperatingSystemConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_OperatingSystemConfiguration_To_gardenlinux_OperatingSystemConfiguration(in *OperatingSystemConfiguration, out *gardenlinux.OperatingSystemConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperatingSystemConfiguration_To_gardenlinux_OperatingSystemConfiguration(in, out, s)
+}
+
+func autoConvert_gardenlinux_OperatingSystemConfiguration_To_v1alpha1_OperatingSystemConfiguration(in *gardenlinux.OperatingSystemConfiguration, out *OperatingSystemConfiguration, s conversion.Scope) error {
+	out.KernelModules = (*KernelModules)(unsafe.Pointer(in.KernelModules))
+	out.Sysctls = (*Sysctls)(unsafe.Pointer(in.Sysctls))
+	out.UserDataFormat = (*UserDataFormat)(unsafe.Pointer(in.UserDataFormat))
+	out.Containerd = (*Containerd)(unsafe.Pointer(in.Containerd))
+	out.NetworkReadiness = (*NetworkReadiness)(unsafe.Pointer(in.NetworkReadiness))
+	out.Network = (*Network)(unsafe.Pointer(in.Network))
+	out.Swap = (*Swap)(unsafe.Pointer(in.Swap))
+	out.NTP = (*NTP)(unsafe.Pointer(in.NTP))
+	out.CABundles = *(*[]CABundle)(unsafe.Pointer(&in.CABundles))
+	out.LocalStorage = (*LocalStorage)(unsafe.Pointer(in.LocalStorage))
+	return nil
+}
+
+// Convert_gardenlinux_OperatingSystemConfiguration_To_v1alpha1_OperatingSystemConfiguration is an autogenerated conversion function.
+func Convert_gardenlinux_OperatingSystemConfiguration_To_v1alpha1_OperatingSystemConfiguration(in *gardenlinux.OperatingSystemConfiguration, out *OperatingSystemConfiguration, s conversion.Scope) error {
+	return autoConvert_gardenlinux_OperatingSystemConfiguration_To_v1alpha1_OperatingSystemConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_OperatingSystemConfigurationStatus_To_gardenlinux_OperatingSystemConfigurationStatus(in *OperatingSystemConfigurationStatus, out *gardenlinux.OperatingSystemConfigurationStatus, s conversion.Scope) error {
+	out.UserData = (*gardenlinux.UserDataStatus)(unsafe.Pointer(in.UserData))
+	out.MemoryOne = (*gardenlinux.MemoryOneStatus)(unsafe.Pointer(in.MemoryOne))
+	out.InPlaceUpdates = (*gardenlinux.InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
+	return nil
+}
+
+// Convert_v1alpha1_OperatingSystemConfigurationStatus_To_gardenlinux_OperatingSystemConfigurationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_OperatingSystemConfigurationStatus_To_gardenlinux_OperatingSystemConfigurationStatus(in *OperatingSystemConfigurationStatus, out *gardenlinux.OperatingSystemConfigurationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperatingSystemConfigurationStatus_To_gardenlinux_OperatingSystemConfigurationStatus(in, out, s)
+}
+
+func autoConvert_gardenlinux_OperatingSystemConfigurationStatus_To_v1alpha1_OperatingSystemConfigurationStatus(in *gardenlinux.OperatingSystemConfigurationStatus, out *OperatingSystemConfigurationStatus, s conversion.Scope) error {
+	out.UserData = (*UserDataStatus)(unsafe.Pointer(in.UserData))
+	out.MemoryOne = (*MemoryOneStatus)(unsafe.Pointer(in.MemoryOne))
+	out.InPlaceUpdates = (*InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
+	return nil
+}
+
+// Convert_gardenlinux_OperatingSystemConfigurationStatus_To_v1alpha1_OperatingSystemConfigurationStatus is an autogenerated conversion function.
+func Convert_gardenlinux_OperatingSystemConfigurationStatus_To_v1alpha1_OperatingSystemConfigurationStatus(in *gardenlinux.OperatingSystemConfigurationStatus, out *OperatingSystemConfigurationStatus, s conversion.Scope) error {
+	return autoConvert_gardenlinux_OperatingSystemConfigurationStatus_To_v1alpha1_OperatingSystemConfigurationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_Route_To_gardenlinux_Route(in *Route, out *gardenlinux.Route, s conversion.Scope) error {
+	out.Destination = in.Destination
+	out.Gateway = (*string)(unsafe.Pointer(in.Gateway))
+	out.Metric = (*int32)(unsafe.Pointer(in.Metric))
+	return nil
+}
+
+// Convert_v1alpha1_Route_To_gardenlinux_Route is an autogenerated conversion function.
+func Convert_v1alpha1_Route_To_gardenlinux_Route(in *Route, out *gardenlinux.Route, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Route_To_gardenlinux_Route(in, out, s)
+}
+
+func autoConvert_gardenlinux_Route_To_v1alpha1_Route(in *gardenlinux.Route, out *Route, s conversion.Scope) error {
+	out.Destination = in.Destination
+	out.Gateway = (*string)(unsafe.Pointer(in.Gateway))
+	out.Metric = (*int32)(unsafe.Pointer(in.Metric))
+	return nil
+}
+
+// Convert_gardenlinux_Route_To_v1alpha1_Route is an autogenerated conversion function.
+func Convert_gardenlinux_Route_To_v1alpha1_Route(in *gardenlinux.Route, out *Route, s conversion.Scope) error {
+	return autoConvert_gardenlinux_Route_To_v1alpha1_Route(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretKeyReference_To_gardenlinux_SecretKeyReference(in *SecretKeyReference, out *gardenlinux.SecretKeyReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DataKey = in.DataKey
+	return nil
+}
+
+// Convert_v1alpha1_SecretKeyReference_To_gardenlinux_SecretKeyReference is an autogenerated conversion function.
+func Convert_v1alpha1_SecretKeyReference_To_gardenlinux_SecretKeyReference(in *SecretKeyReference, out *gardenlinux.SecretKeyReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretKeyReference_To_gardenlinux_SecretKeyReference(in, out, s)
+}
+
+func autoConvert_gardenlinux_SecretKeyReference_To_v1alpha1_SecretKeyReference(in *gardenlinux.SecretKeyReference, out *SecretKeyReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DataKey = in.DataKey
+	return nil
+}
+
+// Convert_gardenlinux_SecretKeyReference_To_v1alpha1_SecretKeyReference is an autogenerated conversion function.
+func Convert_gardenlinux_SecretKeyReference_To_v1alpha1_SecretKeyReference(in *gardenlinux.SecretKeyReference, out *SecretKeyReference, s conversion.Scope) error {
+	return autoConvert_gardenlinux_SecretKeyReference_To_v1alpha1_SecretKeyReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_Swap_To_gardenlinux_Swap(in *Swap, out *gardenlinux.Swap, s conversion.Scope) error {
+	out.Zram = (*gardenlinux.Zram)(unsafe.Pointer(in.Zram))
+	out.File = (*gardenlinux.SwapFile)(unsafe.Pointer(in.File))
+	out.Behavior = (*gardenlinux.SwapBehavior)(unsafe.Pointer(in.Behavior))
+	return nil
+}
+
+// Convert_v1alpha1_Swap_To_gardenlinux_Swap is an autogenerated conversion function.
+func Convert_v1alpha1_Swap_To_gardenlinux_Swap(in *Swap, out *gardenlinux.Swap, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Swap_To_gardenlinux_Swap(in, out, s)
+}
+
+func autoConvert_gardenlinux_Swap_To_v1alpha1_Swap(in *gardenlinux.Swap, out *Swap, s conversion.Scope) error {
+	out.Zram = (*Zram)(unsafe.Pointer(in.Zram))
+	out.File = (*SwapFile)(unsafe.Pointer(in.File))
+	out.Behavior = (*SwapBehavior)(unsafe.Pointer(in.Behavior))
+	return nil
+}
+
+// Convert_gardenlinux_Swap_To_v1alpha1_Swap is an autogenerated conversion function.
+func Convert_gardenlinux_Swap_To_v1alpha1_Swap(in *gardenlinux.Swap, out *Swap, s conversion.Scope) error {
+	return autoConvert_gardenlinux_Swap_To_v1alpha1_Swap(in, out, s)
+}
+
+func autoConvert_v1alpha1_SwapFile_To_gardenlinux_SwapFile(in *SwapFile, out *gardenlinux.SwapFile, s conversion.Scope) error {
+	out.Size = in.Size
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	return nil
+}
+
+// Convert_v1alpha1_SwapFile_To_gardenlinux_SwapFile is an autogenerated conversion function.
+func Convert_v1alpha1_SwapFile_To_gardenlinux_SwapFile(in *SwapFile, out *gardenlinux.SwapFile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SwapFile_To_gardenlinux_SwapFile(in, out, s)
+}
+
+func autoConvert_gardenlinux_SwapFile_To_v1alpha1_SwapFile(in *gardenlinux.SwapFile, out *SwapFile, s conversion.Scope) error {
+	out.Size = in.Size
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	return nil
+}
+
+// Convert_gardenlinux_SwapFile_To_v1alpha1_SwapFile is an autogenerated conversion function.
+func Convert_gardenlinux_SwapFile_To_v1alpha1_SwapFile(in *gardenlinux.SwapFile, out *SwapFile, s conversion.Scope) error {
+	return autoConvert_gardenlinux_SwapFile_To_v1alpha1_SwapFile(in, out, s)
+}
+
+func autoConvert_v1alpha1_Sysctls_To_gardenlinux_Sysctls(in *Sysctls, out *gardenlinux.Sysctls, s conversion.Scope) error {
+	out.Profiles = *(*[]gardenlinux.SysctlProfile)(unsafe.Pointer(&in.Profiles))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_v1alpha1_Sysctls_To_gardenlinux_Sysctls is an autogenerated conversion function.
+func Convert_v1alpha1_Sysctls_To_gardenlinux_Sysctls(in *Sysctls, out *gardenlinux.Sysctls, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Sysctls_To_gardenlinux_Sysctls(in, out, s)
+}
+
+func autoConvert_gardenlinux_Sysctls_To_v1alpha1_Sysctls(in *gardenlinux.Sysctls, out *Sysctls, s conversion.Scope) error {
+	out.Profiles = *(*[]SysctlProfile)(unsafe.Pointer(&in.Profiles))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_gardenlinux_Sysctls_To_v1alpha1_Sysctls is an autogenerated conversion function.
+func Convert_gardenlinux_Sysctls_To_v1alpha1_Sysctls(in *gardenlinux.Sysctls, out *Sysctls, s conversion.Scope) error {
+	return autoConvert_gardenlinux_Sysctls_To_v1alpha1_Sysctls(in, out, s)
+}
+
+func autoConvert_v1alpha1_UserDataStatus_To_gardenlinux_UserDataStatus(in *UserDataStatus, out *gardenlinux.UserDataStatus, s conversion.Scope) error {
+	out.Format = gardenlinux.UserDataFormat(in.Format)
+	out.Hash = in.Hash
+	out.Size = in.Size
+	out.Compressed = in.Compressed
+	return nil
+}
+
+// Convert_v1alpha1_UserDataStatus_To_gardenlinux_UserDataStatus is an autogenerated conversion function.
+func Convert_v1alpha1_UserDataStatus_To_gardenlinux_UserDataStatus(in *UserDataStatus, out *gardenlinux.UserDataStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UserDataStatus_To_gardenlinux_UserDataStatus(in, out, s)
+}
+
+func autoConvert_gardenlinux_UserDataStatus_To_v1alpha1_UserDataStatus(in *gardenlinux.UserDataStatus, out *UserDataStatus, s conversion.Scope) error {
+	out.Format = UserDataFormat(in.Format)
+	out.Hash = in.Hash
+	out.Size = in.Size
+	out.Compressed = in.Compressed
+	return nil
+}
+
+// Convert_gardenlinux_UserDataStatus_To_v1alpha1_UserDataStatus is an autogenerated conversion function.
+func Convert_gardenlinux_UserDataStatus_To_v1alpha1_UserDataStatus(in *gardenlinux.UserDataStatus, out *UserDataStatus, s conversion.Scope) error {
+	return autoConvert_gardenlinux_UserDataStatus_To_v1alpha1_UserDataStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_Zram_To_gardenlinux_Zram(in *Zram, out *gardenlinux.Zram, s conversion.Scope) error {
+	out.Size = in.Size
+	out.CompressionAlgorithm = (*string)(unsafe.Pointer(in.CompressionAlgorithm))
+	return nil
+}
+
+// Convert_v1alpha1_Zram_To_gardenlinux_Zram is an autogenerated conversion function.
+func Convert_v1alpha1_Zram_To_gardenlinux_Zram(in *Zram, out *gardenlinux.Zram, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Zram_To_gardenlinux_Zram(in, out, s)
+}
+
+func autoConvert_gardenlinux_Zram_To_v1alpha1_Zram(in *gardenlinux.Zram, out *Zram, s conversion.Scope) error {
+	out.Size = in.Size
+	out.CompressionAlgorithm = (*string)(unsafe.Pointer(in.CompressionAlgorithm))
+	return nil
+}
+
+// Convert_gardenlinux_Zram_To_v1alpha1_Zram is an autogenerated conversion function.
+func Convert_gardenlinux_Zram_To_v1alpha1_Zram(in *gardenlinux.Zram, out *Zram, s conversion.Scope) error {
+	return autoConvert_gardenlinux_Zram_To_v1alpha1_Zram(in, out, s)
+}
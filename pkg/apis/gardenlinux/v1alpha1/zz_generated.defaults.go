@@ -0,0 +1,55 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by defaulter-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// RegisterDefaults adds defaulters functions to the given scheme.
+// Public to allow building arbitrary schemes.
+// All generated defaulters are covering - they call all nested defaulters.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&OperatingSystemConfiguration{}, func(obj interface{}) {
+		SetObjectDefaults_OperatingSystemConfiguration(obj.(*OperatingSystemConfiguration))
+	})
+	return nil
+}
+
+func SetObjectDefaults_OperatingSystemConfiguration(in *OperatingSystemConfiguration) {
+	SetDefaults_OperatingSystemConfiguration(in)
+	if in.NetworkReadiness != nil {
+		SetDefaults_NetworkReadiness(in.NetworkReadiness)
+	}
+	if in.Network != nil {
+		for i := range in.Network.Interfaces {
+			a := &in.Network.Interfaces[i]
+			SetDefaults_NetworkInterface(a)
+			if a.DHCP != nil {
+				SetDefaults_DHCP(a.DHCP)
+			}
+		}
+	}
+	if in.Swap != nil {
+		SetDefaults_Swap(in.Swap)
+		if in.Swap.Zram != nil {
+			SetDefaults_Zram(in.Swap.Zram)
+		}
+		if in.Swap.File != nil {
+			SetDefaults_SwapFile(in.Swap.File)
+		}
+	}
+	if in.NTP != nil {
+		SetDefaults_NTP(in.NTP)
+	}
+	if in.LocalStorage != nil {
+		SetDefaults_LocalStorage(in.LocalStorage)
+	}
+}
@@ -0,0 +1,599 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+)
+
+var (
+	kernelModuleNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	interfaceMatchRegex   = regexp.MustCompile(`^[a-zA-Z0-9_.:@*?\[\]!-]+$`)
+	absolutePathRegex     = regexp.MustCompile(`^(/[a-zA-Z0-9_.-]+)+$`)
+	diskPathRegex         = regexp.MustCompile(`^/dev/[a-zA-Z0-9_.:@*?\[\]!/-]+$`)
+	diskModelRegex        = regexp.MustCompile(`^[[:print:]]+$`)
+	sysctlKeyRegex        = regexp.MustCompile(`^[a-z0-9_]+(\.[a-zA-Z0-9_-]+)+$`)
+
+	supportedSysctlProfiles = sets.New(
+		apisgardenlinux.SysctlProfileHighConnectionCount,
+		apisgardenlinux.SysctlProfileElasticsearch,
+	)
+
+	supportedUserDataFormats = sets.New(
+		apisgardenlinux.UserDataFormatBash,
+		apisgardenlinux.UserDataFormatCloudConfig,
+		apisgardenlinux.UserDataFormatMIMEMultipart,
+		apisgardenlinux.UserDataFormatIgnition,
+	)
+
+	supportedContainerdMajorVersions = sets.New("1", "2")
+
+	supportedZramCompressionAlgorithms = sets.New("lzo", "lzo-rle", "lz4", "lz4hc", "zstd", "deflate", "842")
+
+	supportedSwapBehaviors = sets.New(
+		apisgardenlinux.SwapBehaviorLimitedSwap,
+		apisgardenlinux.SwapBehaviorNoSwap,
+	)
+
+	supportedNTPDaemons = sets.New(
+		apisgardenlinux.NTPDaemonTimesyncd,
+		apisgardenlinux.NTPDaemonChrony,
+	)
+
+	supportedFilesystems = sets.New(
+		apisgardenlinux.FilesystemExt4,
+		apisgardenlinux.FilesystemXFS,
+	)
+
+	supportedDHCPModes = sets.New(
+		apisgardenlinux.DHCPModeYes,
+		apisgardenlinux.DHCPModeNo,
+		apisgardenlinux.DHCPModeIPv4,
+		apisgardenlinux.DHCPModeIPv6,
+	)
+)
+
+const (
+	minMTU = 68
+	maxMTU = 65535
+)
+
+var minSwapSize = resource.MustParse("1Mi")
+
+// ValidateOperatingSystemConfiguration validates the given Garden Linux operating system configuration.
+func ValidateOperatingSystemConfiguration(config *apisgardenlinux.OperatingSystemConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.KernelModules != nil {
+		allErrs = append(allErrs, validateKernelModules(config.KernelModules, fldPath.Child("kernelModules"))...)
+	}
+
+	if config.Sysctls != nil {
+		allErrs = append(allErrs, validateSysctls(config.Sysctls, fldPath.Child("sysctls"))...)
+	}
+
+	if config.UserDataFormat != nil {
+		allErrs = append(allErrs, ValidateUserDataFormat(*config.UserDataFormat, fldPath.Child("userDataFormat"))...)
+	}
+
+	if config.Containerd != nil {
+		allErrs = append(allErrs, validateContainerd(config.Containerd, fldPath.Child("containerd"))...)
+	}
+
+	if config.NetworkReadiness != nil {
+		allErrs = append(allErrs, validateNetworkReadiness(config.NetworkReadiness, fldPath.Child("networkReadiness"))...)
+	}
+
+	if config.Network != nil {
+		allErrs = append(allErrs, validateNetwork(config.Network, fldPath.Child("network"))...)
+	}
+
+	if config.Swap != nil {
+		allErrs = append(allErrs, validateSwap(config.Swap, fldPath.Child("swap"))...)
+	}
+
+	if config.NTP != nil {
+		allErrs = append(allErrs, validateNTP(config.NTP, fldPath.Child("ntp"))...)
+	}
+
+	allErrs = append(allErrs, validateCABundles(config.CABundles, fldPath.Child("caBundles"))...)
+
+	if config.LocalStorage != nil {
+		allErrs = append(allErrs, validateLocalStorage(config.LocalStorage, fldPath.Child("localStorage"))...)
+	}
+
+	return allErrs
+}
+
+func validateKernelModules(modules *apisgardenlinux.KernelModules, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	loaded := sets.New[string]()
+	for i, name := range modules.Load {
+		idxPath := fldPath.Child("load").Index(i)
+		allErrs = append(allErrs, ValidateKernelModuleName(name, idxPath)...)
+		if loaded.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		loaded.Insert(name)
+	}
+
+	blacklisted := sets.New[string]()
+	for i, name := range modules.Blacklist {
+		idxPath := fldPath.Child("blacklist").Index(i)
+		allErrs = append(allErrs, ValidateKernelModuleName(name, idxPath)...)
+		if blacklisted.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		if loaded.Has(name) {
+			allErrs = append(allErrs, field.Forbidden(idxPath, "kernel module must not be loaded and blacklisted at the same time"))
+		}
+		blacklisted.Insert(name)
+	}
+
+	return allErrs
+}
+
+// ValidateKernelModuleName validates the name of a kernel module.
+func ValidateKernelModuleName(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !kernelModuleNameRegex.MatchString(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "kernel module names must only consist of alphanumeric characters, '-' or '_'"))
+	}
+
+	return allErrs
+}
+
+func validateSysctls(sysctls *apisgardenlinux.Sysctls, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, profile := range sysctls.Profiles {
+		if !supportedSysctlProfiles.Has(profile) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("profiles").Index(i), profile, sets.List(supportedSysctlProfiles)))
+		}
+	}
+
+	allErrs = append(allErrs, ValidateSysctlParameters(sysctls.Parameters, fldPath.Child("parameters"))...)
+
+	return allErrs
+}
+
+// ValidateSysctlParameters validates the names and values of the given kernel parameters.
+func ValidateSysctlParameters(parameters map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for key, value := range parameters {
+		keyPath := fldPath.Key(key)
+		if !sysctlKeyRegex.MatchString(key) {
+			allErrs = append(allErrs, field.Invalid(keyPath, key, "kernel parameter names must consist of dot-separated segments of alphanumeric characters, '-' or '_'"))
+		}
+		if len(strings.TrimSpace(value)) == 0 {
+			allErrs = append(allErrs, field.Required(keyPath, "kernel parameter values must not be empty"))
+		}
+		if strings.ContainsAny(value, "\n\r") {
+			allErrs = append(allErrs, field.Invalid(keyPath, value, "kernel parameter values must not contain line breaks"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateContainerd(containerd *apisgardenlinux.Containerd, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if containerd.MajorVersion != nil {
+		if majorVersion := strconv.Itoa(int(*containerd.MajorVersion)); !supportedContainerdMajorVersions.Has(majorVersion) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("majorVersion"), majorVersion, sets.List(supportedContainerdMajorVersions)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNetworkReadiness(readiness *apisgardenlinux.NetworkReadiness, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if readiness.Timeout != nil && readiness.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), readiness.Timeout.Duration.String(), "timeout must be positive"))
+	}
+
+	if readiness.RetryInterval != nil {
+		if readiness.RetryInterval.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("retryInterval"), readiness.RetryInterval.Duration.String(), "retry interval must be positive"))
+		} else if readiness.Timeout != nil && readiness.RetryInterval.Duration > readiness.Timeout.Duration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("retryInterval"), readiness.RetryInterval.Duration.String(), "retry interval must not be longer than the timeout"))
+		}
+	}
+
+	endpoints := sets.New[string]()
+	for i, endpoint := range readiness.Endpoints {
+		idxPath := fldPath.Child("endpoints").Index(i)
+		allErrs = append(allErrs, validateEndpoint(endpoint, idxPath)...)
+		if endpoints.Has(endpoint) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, endpoint))
+		}
+		endpoints.Insert(endpoint)
+	}
+
+	return allErrs
+}
+
+func validateEndpoint(endpoint string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, endpoint, "endpoints must have the form host:port"))
+	}
+
+	if net.ParseIP(host) == nil && len(validation.IsDNS1123Subdomain(host)) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, endpoint, "endpoint host must be an IP address or a DNS name"))
+	}
+
+	if portNum, err := strconv.Atoi(port); err != nil || len(validation.IsValidPortNum(portNum)) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, endpoint, "endpoint port must be a number between 1 and 65535"))
+	}
+
+	return allErrs
+}
+
+func validateNetwork(network *apisgardenlinux.Network, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, iface := range network.Interfaces {
+		idxPath := fldPath.Child("interfaces").Index(i)
+		allErrs = append(allErrs, validateNetworkInterface(iface, idxPath)...)
+		if names.Has(iface.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), iface.Name))
+		}
+		names.Insert(iface.Name)
+	}
+
+	return allErrs
+}
+
+func validateNetworkInterface(iface apisgardenlinux.NetworkInterface, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(iface.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name is required"))
+	} else {
+		for _, msg := range validation.IsDNS1123Label(iface.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), iface.Name, msg))
+		}
+	}
+
+	allErrs = append(allErrs, validateNetworkMatch(iface.Match, fldPath.Child("match"))...)
+
+	if iface.MTU != nil && (*iface.MTU < minMTU || *iface.MTU > maxMTU) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mtu"), *iface.MTU, fmt.Sprintf("MTU must be between %d and %d", minMTU, maxMTU)))
+	}
+
+	if dhcp := iface.DHCP; dhcp != nil {
+		if dhcp.Mode != nil && !supportedDHCPModes.Has(*dhcp.Mode) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("dhcp", "mode"), *dhcp.Mode, sets.List(supportedDHCPModes)))
+		}
+		if dhcp.RouteMetric != nil && *dhcp.RouteMetric < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("dhcp", "routeMetric"), *dhcp.RouteMetric, "route metric must not be negative"))
+		}
+	}
+
+	addresses := sets.New[string]()
+	for i, address := range iface.Addresses {
+		idxPath := fldPath.Child("addresses").Index(i)
+		if _, _, err := net.ParseCIDR(address); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, address, "address must be an IP address with prefix length in CIDR notation"))
+		}
+		if addresses.Has(address) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, address))
+		}
+		addresses.Insert(address)
+	}
+
+	for i, route := range iface.Routes {
+		allErrs = append(allErrs, validateRoute(route, fldPath.Child("routes").Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateNetworkMatch(match apisgardenlinux.NetworkMatch, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(match.Names) == 0 && len(match.MACAddresses) == 0 && len(match.Drivers) == 0 {
+		return append(allErrs, field.Required(fldPath, "at least one of names, macAddresses or drivers is required"))
+	}
+
+	for i, name := range match.Names {
+		if !interfaceMatchRegex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("names").Index(i), name, "interface names must only consist of alphanumeric characters, glob characters, '-', '_', '.', ':' or '@'"))
+		}
+	}
+
+	for i, address := range match.MACAddresses {
+		if _, err := net.ParseMAC(address); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("macAddresses").Index(i), address, "must be a valid hardware address"))
+		}
+	}
+
+	for i, driver := range match.Drivers {
+		if !interfaceMatchRegex.MatchString(driver) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("drivers").Index(i), driver, "driver names must only consist of alphanumeric characters, glob characters, '-', '_', '.', ':' or '@'"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateRoute(route apisgardenlinux.Route, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	_, destination, err := net.ParseCIDR(route.Destination)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("destination"), route.Destination, "destination must be a network in CIDR notation"))
+	}
+
+	if route.Gateway != nil {
+		gateway := net.ParseIP(*route.Gateway)
+		if gateway == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("gateway"), *route.Gateway, "gateway must be an IP address"))
+		} else if destination != nil && (gateway.To4() == nil) != (destination.IP.To4() == nil) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("gateway"), *route.Gateway, "gateway must have the same IP family as the destination"))
+		}
+	}
+
+	if route.Metric != nil && *route.Metric < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("metric"), *route.Metric, "metric must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateSwap(swap *apisgardenlinux.Swap, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case swap.Zram == nil && swap.File == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "either zram or file is required"))
+	case swap.Zram != nil && swap.File != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("file"), "must not be set together with zram"))
+	}
+
+	if zram := swap.Zram; zram != nil {
+		allErrs = append(allErrs, validateSwapSize(zram.Size, fldPath.Child("zram", "size"))...)
+		if zram.CompressionAlgorithm != nil && !supportedZramCompressionAlgorithms.Has(*zram.CompressionAlgorithm) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("zram", "compressionAlgorithm"), *zram.CompressionAlgorithm, sets.List(supportedZramCompressionAlgorithms)))
+		}
+	}
+
+	if file := swap.File; file != nil {
+		allErrs = append(allErrs, validateSwapSize(file.Size, fldPath.Child("file", "size"))...)
+		if file.Path != nil {
+			allErrs = append(allErrs, ValidateAbsolutePath(*file.Path, fldPath.Child("file", "path"))...)
+		}
+	}
+
+	if swap.Behavior != nil && !supportedSwapBehaviors.Has(*swap.Behavior) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("behavior"), *swap.Behavior, sets.List(supportedSwapBehaviors)))
+	}
+
+	return allErrs
+}
+
+func validateSwapSize(size resource.Quantity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if size.Cmp(minSwapSize) < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, size.String(), "size must be at least "+minSwapSize.String()))
+	}
+
+	return allErrs
+}
+
+// ValidateAbsolutePath validates that the given path is absolute and normalized.
+func ValidateAbsolutePath(p string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !absolutePathRegex.MatchString(p) || path.Clean(p) != p {
+		allErrs = append(allErrs, field.Invalid(fldPath, p, "path must be an absolute, normalized path consisting of alphanumeric characters, '-', '_' or '.'"))
+	}
+
+	return allErrs
+}
+
+func validateLocalStorage(storage *apisgardenlinux.LocalStorage, fldPath *field.Path) field.ErrorList {
+	allErrs := validateDiskSelector(storage.Disks, fldPath.Child("disks"))
+
+	if storage.Filesystem != nil && !supportedFilesystems.Has(*storage.Filesystem) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("filesystem"), *storage.Filesystem, sets.List(supportedFilesystems)))
+	}
+
+	var mountPoint string
+	if storage.MountPoint != nil {
+		mountPoint = *storage.MountPoint
+		allErrs = append(allErrs, ValidateAbsolutePath(mountPoint, fldPath.Child("mountPoint"))...)
+	}
+
+	var directories []string
+	for i, directory := range storage.Directories {
+		idxPath := fldPath.Child("directories").Index(i)
+
+		allErrs = append(allErrs, ValidateAbsolutePath(directory, idxPath)...)
+		if mountPoint != "" && (isSubPath(directory, mountPoint) || isSubPath(mountPoint, directory)) {
+			allErrs = append(allErrs, field.Invalid(idxPath, directory, "directory must not overlap with the mount point"))
+		}
+		for _, other := range directories {
+			if isSubPath(directory, other) || isSubPath(other, directory) {
+				allErrs = append(allErrs, field.Invalid(idxPath, directory, fmt.Sprintf("directory must not overlap with directory %q", other)))
+			}
+		}
+		directories = append(directories, directory)
+	}
+
+	return allErrs
+}
+
+func validateDiskSelector(selector apisgardenlinux.DiskSelector, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(selector.Models) == 0 && len(selector.Paths) == 0 && selector.MinSize == nil && selector.MaxSize == nil {
+		return append(allErrs, field.Required(fldPath, "at least one of models, paths, minSize or maxSize is required"))
+	}
+
+	for i, model := range selector.Models {
+		if !diskModelRegex.MatchString(model) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("models").Index(i), model, "disk models must only consist of printable characters"))
+		}
+	}
+
+	for i, p := range selector.Paths {
+		if !diskPathRegex.MatchString(p) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("paths").Index(i), p, "device paths must start with /dev/ and only consist of alphanumeric characters, glob characters, '-', '_', '.', ':', '@' or '/'"))
+		}
+	}
+
+	if selector.MinSize != nil && selector.MinSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minSize"), selector.MinSize.String(), "size must be positive"))
+	}
+	if selector.MaxSize != nil && selector.MaxSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), selector.MaxSize.String(), "size must be positive"))
+	}
+	if selector.MinSize != nil && selector.MaxSize != nil && selector.MinSize.Cmp(*selector.MaxSize) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), selector.MaxSize.String(), "size must not be less than minSize"))
+	}
+
+	return allErrs
+}
+
+// isSubPath returns true if the given path equals the given parent path or is located below it.
+func isSubPath(p, parent string) bool {
+	return p == parent || strings.HasPrefix(p, strings.TrimSuffix(parent, "/")+"/")
+}
+
+func validateNTP(ntp *apisgardenlinux.NTP, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ntp.Daemon != nil && !supportedNTPDaemons.Has(*ntp.Daemon) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("daemon"), *ntp.Daemon, sets.List(supportedNTPDaemons)))
+	}
+
+	if len(ntp.Servers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("servers"), "at least one NTP server is required"))
+	}
+
+	servers := sets.New[string]()
+	for i, server := range ntp.Servers {
+		idxPath := fldPath.Child("servers").Index(i)
+		if net.ParseIP(server) == nil && len(validation.IsDNS1123Subdomain(server)) > 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath, server, "NTP server must be an IP address or a DNS name"))
+		}
+		if servers.Has(server) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, server))
+		}
+		servers.Insert(server)
+	}
+
+	return allErrs
+}
+
+func validateCABundles(bundles []apisgardenlinux.CABundle, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, bundle := range bundles {
+		idxPath := fldPath.Index(i)
+
+		if len(bundle.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name is required"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(bundle.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), bundle.Name, msg))
+			}
+		}
+		if names.Has(bundle.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), bundle.Name))
+		}
+		names.Insert(bundle.Name)
+
+		switch {
+		case bundle.Inline == nil && bundle.SecretRef == nil:
+			allErrs = append(allErrs, field.Required(idxPath, "either inline or secretRef is required"))
+		case bundle.Inline != nil && bundle.SecretRef != nil:
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("secretRef"), "must not be set together with inline"))
+		}
+
+		if bundle.Inline != nil {
+			if err := ValidateCertificateBundle([]byte(*bundle.Inline)); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("inline"), "<bundle>", err.Error()))
+			}
+		}
+
+		if ref := bundle.SecretRef; ref != nil {
+			for _, msg := range validation.IsDNS1123Subdomain(ref.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("secretRef", "name"), ref.Name, msg))
+			}
+			for _, msg := range validation.IsConfigMapKey(ref.DataKey) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("secretRef", "dataKey"), ref.DataKey, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateCertificateBundle validates that the given data is a PEM encoded bundle of at least one certificate which
+// does not contain anything else.
+func ValidateCertificateBundle(data []byte) error {
+	var count int
+
+	for rest := bytes.TrimSpace(data); len(rest) > 0; rest = bytes.TrimSpace(rest) {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return errors.New("bundle must only contain PEM encoded certificates")
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("bundle must only contain certificates, found PEM block of type %q", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("failed to parse certificate %d of bundle: %w", count+1, err)
+		}
+		count++
+	}
+
+	if count == 0 {
+		return errors.New("bundle must contain at least one certificate")
+	}
+	return nil
+}
+
+// ValidateUserDataFormat validates the given format of the user data which provisions the nodes.
+func ValidateUserDataFormat(format apisgardenlinux.UserDataFormat, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedUserDataFormats.Has(format) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, format, sets.List(supportedUserDataFormats)))
+	}
+
+	return allErrs
+}
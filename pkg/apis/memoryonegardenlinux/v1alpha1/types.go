@@ -16,12 +16,17 @@ type OperatingSystemConfiguration struct {
 	metav1.TypeMeta `json:",inline"`
 
 	// MemoryTopology allows to configure the `mem_topology` parameter. If not present, it will default to `2`.
+	// It must be a positive integer.
 	// +optional
 	MemoryTopology *string `json:"memoryTopology,omitempty"`
 	// SystemMemory allows to configure the `system_memory` parameter. If not present, it will default to `6x`.
+	// It must be a multiplier of the physical memory of the node (e.g. `6x`) or an absolute memory size (e.g. `512Gi`).
 	// +optional
 	SystemMemory *string `json:"systemMemory,omitempty"`
-	// VsmpConfiguration allows to configure any setting of vSMP
+	// VsmpConfiguration allows to configure any setting of vSMP. The values of the known parameters are validated, all other
+	// parameters are passed through to vSMP as they are. Unknown parameters as well as `mem_topology` and `system_memory`,
+	// which are overridden by MemoryTopology and SystemMemory, are reported as warnings in the provider status of the
+	// OperatingSystemConfig.
 	// +optional
 	VsmpConfiguration map[string]string `json:"vsmpConfiguration,omitempty"`
 }
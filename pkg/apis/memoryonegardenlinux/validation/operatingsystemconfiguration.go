@@ -5,21 +5,29 @@
 package validation
 
 import (
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
-	memoryonev1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/v1alpha1"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux"
 )
 
-var (
-	supportedInjectedFeatures = []string{"opt_enable", "feature_enable", "debug_features_enable"}
-)
-
-func ValidateOperatingSystemConfig(osconfig *memoryonev1alpha1.OperatingSystemConfiguration, fldPath *field.Path) field.ErrorList {
+// ValidateOperatingSystemConfig validates the given MemoryOne on Garden Linux operating system configuration.
+func ValidateOperatingSystemConfig(osconfig *memoryonegardenlinux.OperatingSystemConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	if osconfig.MemoryTopology != nil {
+		allErrs = append(allErrs, validateVsmpParameterValue(VsmpParameterMemoryTopology, *osconfig.MemoryTopology, fldPath.Child("memoryTopology"))...)
+	}
+
+	if osconfig.SystemMemory != nil {
+		allErrs = append(allErrs, validateVsmpParameterValue(VsmpParameterSystemMemory, *osconfig.SystemMemory, fldPath.Child("systemMemory"))...)
+	}
+
 	if osconfig.VsmpConfiguration != nil {
 		allErrs = append(allErrs, validateVsmpConfig(osconfig.VsmpConfiguration, fldPath.Child("vsmpConfiguration"))...)
 	}
@@ -36,10 +44,39 @@ func validateVsmpConfig(config map[string]string, fldPath *field.Path) field.Err
 				allErrs = append(allErrs, field.Invalid(fldPath.Key(k), k, e))
 			}
 		}
+
 		if strings.Contains(v, ";") {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Key(k), "vSMP configuration values must not contain semicola"))
+			continue
 		}
+
+		allErrs = append(allErrs, validateVsmpParameterValue(k, v, fldPath.Key(k))...)
 	}
 
 	return allErrs
 }
+
+// WarningsForOperatingSystemConfig returns warnings for the given MemoryOne on Garden Linux operating system
+// configuration which do not make it invalid: vSMP parameters which are unknown and thus passed through without
+// validation, and vSMP parameters which are overridden by the dedicated fields.
+func WarningsForOperatingSystemConfig(osconfig *memoryonegardenlinux.OperatingSystemConfiguration, fldPath *field.Path) []string {
+	var (
+		warnings []string
+		vsmpPath = fldPath.Child("vsmpConfiguration")
+	)
+
+	for _, k := range slices.Sorted(maps.Keys(osconfig.VsmpConfiguration)) {
+		switch k {
+		case VsmpParameterMemoryTopology:
+			warnings = append(warnings, fmt.Sprintf("%s: vSMP parameter is overridden by %s", vsmpPath.Key(k), fldPath.Child("memoryTopology")))
+		case VsmpParameterSystemMemory:
+			warnings = append(warnings, fmt.Sprintf("%s: vSMP parameter is overridden by %s", vsmpPath.Key(k), fldPath.Child("systemMemory")))
+		default:
+			if _, ok := knownVsmpParameters[k]; !ok {
+				warnings = append(warnings, fmt.Sprintf("%s: unknown vSMP parameter is passed through without validation", vsmpPath.Key(k)))
+			}
+		}
+	}
+
+	return warnings
+}
@@ -9,9 +9,11 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
-	memoryonev1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/v1alpha1"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/validation"
 )
 
@@ -22,13 +24,13 @@ func TestOperatingSystemConfig(t *testing.T) {
 
 var _ = Describe("Operatingsystemconfiguration", func() {
 	var (
-		osc *memoryonev1alpha1.OperatingSystemConfiguration
+		osc *memoryonegardenlinux.OperatingSystemConfiguration
 
 		fldPath = field.NewPath("")
 	)
 
 	BeforeEach(func() {
-		osc = &memoryonev1alpha1.OperatingSystemConfiguration{}
+		osc = &memoryonegardenlinux.OperatingSystemConfiguration{}
 	})
 
 	It("should reject vSMP configuration keys containing forbidden characters", func() {
@@ -60,4 +62,81 @@ var _ = Describe("Operatingsystemconfiguration", func() {
 		allErrs := validation.ValidateOperatingSystemConfig(osc, fldPath)
 		Expect(allErrs).To(BeEmpty())
 	})
+
+	Context("known vSMP parameters", func() {
+		It("should accept valid values", func() {
+			osc.MemoryTopology = ptr.To("32")
+			osc.SystemMemory = ptr.To("512Gi")
+			osc.VsmpConfiguration = map[string]string{
+				"mem_topology":  "1",
+				"system_memory": "6x",
+			}
+
+			Expect(validation.ValidateOperatingSystemConfig(osc, fldPath)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject invalid memory topologies",
+			func(topology string) {
+				osc.MemoryTopology = ptr.To(topology)
+
+				Expect(validation.ValidateOperatingSystemConfig(osc, field.NewPath("providerConfig"))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("providerConfig.memoryTopology"),
+					"Detail": Equal("mem_topology must be a positive integer"),
+				}))))
+			},
+			Entry("not an integer", "two"),
+			Entry("empty", ""),
+			Entry("zero", "0"),
+			Entry("negative", "-1"),
+		)
+
+		DescribeTable("should reject invalid system memory",
+			func(memory string) {
+				osc.VsmpConfiguration = map[string]string{"system_memory": memory}
+
+				Expect(validation.ValidateOperatingSystemConfig(osc, field.NewPath("providerConfig"))).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("providerConfig.vsmpConfiguration[system_memory]"),
+					"Detail": Equal("system_memory must be a multiplier of the physical memory (e.g. 6x) or a positive memory size (e.g. 512Gi)"),
+				}))))
+			},
+			Entry("zero multiplier", "0x"),
+			Entry("fractional multiplier", "1.5x"),
+			Entry("zero size", "0"),
+			Entry("negative size", "-1Gi"),
+			Entry("no size", "lots"),
+		)
+
+		It("should not validate the values of unknown parameters", func() {
+			osc.VsmpConfiguration = map[string]string{"some_parameter": "any value", "opt_enable": "maybe"}
+
+			Expect(validation.ValidateOperatingSystemConfig(osc, fldPath)).To(BeEmpty())
+		})
+	})
+
+	Context("warnings", func() {
+		It("should not return warnings for known parameters", func() {
+			osc.MemoryTopology = ptr.To("3")
+			osc.SystemMemory = ptr.To("7x")
+
+			Expect(validation.WarningsForOperatingSystemConfig(osc, field.NewPath("providerConfig"))).To(BeEmpty())
+		})
+
+		It("should warn about unknown and overridden parameters", func() {
+			osc.VsmpConfiguration = map[string]string{
+				"system_memory": "7x",
+				"foo":           "bar",
+				"abc":           "xyz",
+				"opt_enable":    "1",
+			}
+
+			Expect(validation.WarningsForOperatingSystemConfig(osc, field.NewPath("providerConfig"))).To(Equal([]string{
+				"providerConfig.vsmpConfiguration[abc]: unknown vSMP parameter is passed through without validation",
+				"providerConfig.vsmpConfiguration[foo]: unknown vSMP parameter is passed through without validation",
+				"providerConfig.vsmpConfiguration[opt_enable]: unknown vSMP parameter is passed through without validation",
+				"providerConfig.vsmpConfiguration[system_memory]: vSMP parameter is overridden by providerConfig.systemMemory",
+			}))
+		})
+	})
 })
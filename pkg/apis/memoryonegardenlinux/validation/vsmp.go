@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// VsmpParameterMemoryTopology is the vSMP parameter which is configured by the `memoryTopology` field.
+	VsmpParameterMemoryTopology = "mem_topology"
+	// VsmpParameterSystemMemory is the vSMP parameter which is configured by the `systemMemory` field.
+	VsmpParameterSystemMemory = "system_memory"
+)
+
+// vsmpParameterType is the type of the value of a known vSMP parameter.
+type vsmpParameterType int
+
+const (
+	// vsmpParameterTypePositiveInteger is the type of parameters whose value is a positive integer.
+	vsmpParameterTypePositiveInteger vsmpParameterType = iota
+	// vsmpParameterTypeMemory is the type of parameters whose value is either a multiplier of the physical memory of
+	// the node (e.g. `6x`) or an absolute memory size (e.g. `512Gi`).
+	vsmpParameterTypeMemory
+)
+
+var (
+	// knownVsmpParameters are the vSMP parameters whose values are validated. All other parameters are passed through
+	// to vSMP as they are and reported as warnings.
+	// There is no public reference of the vSMP parameters, hence only the parameters of the dedicated fields
+	// `memoryTopology` and `systemMemory` are validated, against the formats of their defaults (`2` and `6x`) and
+	// without upper bounds. Further parameters must only be added here together with a reference to their schema.
+	knownVsmpParameters = map[string]vsmpParameterType{
+		VsmpParameterMemoryTopology: vsmpParameterTypePositiveInteger,
+		VsmpParameterSystemMemory:   vsmpParameterTypeMemory,
+	}
+
+	memoryMultiplierRegex = regexp.MustCompile(`^[1-9][0-9]*x$`)
+)
+
+// validateVsmpParameterValue validates the value of the known vSMP parameter with the given name. Values of unknown
+// parameters are not validated.
+func validateVsmpParameterValue(name, value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	typ, ok := knownVsmpParameters[name]
+	if !ok {
+		return allErrs
+	}
+
+	switch typ {
+	case vsmpParameterTypePositiveInteger:
+		if i, err := strconv.ParseInt(value, 10, 64); err != nil || i < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("%s must be a positive integer", name)))
+		}
+
+	case vsmpParameterTypeMemory:
+		if !memoryMultiplierRegex.MatchString(value) {
+			if quantity, err := resource.ParseQuantity(value); err != nil || quantity.Sign() <= 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("%s must be a multiplier of the physical memory (e.g. 6x) or a positive memory size (e.g. 512Gi)", name)))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/gardener/gardener/extensions/pkg/util"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config/loader"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/controller/operatingsystemconfig"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/features"
+)
+
+// ConfigFlag is the name of the command line flag to specify the file with the ControllerConfiguration.
+const ConfigFlag = "config"
+
+// ConfigOptions are command line options that can be set for config.ControllerConfiguration.
+type ConfigOptions struct {
+	// ConfigFilePath is the path to the file with the ControllerConfiguration.
+	ConfigFilePath string
+
+	config *Config
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *ConfigOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ConfigFilePath, ConfigFlag, "", "Path to the file with the ControllerConfiguration of the extension. If not set, the defaults apply.")
+}
+
+// Complete implements Completer.Complete.
+func (o *ConfigOptions) Complete() error {
+	var (
+		cfg *config.ControllerConfiguration
+		err error
+	)
+
+	if o.ConfigFilePath == "" {
+		cfg, err = loader.Default()
+	} else {
+		cfg, err = loader.LoadFromFile(o.ConfigFilePath)
+	}
+	if err != nil {
+		return err
+	}
+
+	o.config = &Config{Config: cfg}
+	return nil
+}
+
+// Completed returns the completed Config. Only call this if `Complete` was successful.
+func (o *ConfigOptions) Completed() *Config {
+	return o.config
+}
+
+// Config is a completed controller configuration.
+type Config struct {
+	// Config is the ControllerConfiguration.
+	Config *config.ControllerConfiguration
+}
+
+// ApplyClientConnection applies the client connection settings of the configuration to the given REST config.
+func (c *Config) ApplyClientConnection(restConfig *rest.Config) {
+	util.ApplyClientConnectionConfigurationToRESTConfig(c.Config.ClientConnection, restConfig)
+}
+
+// ApplyFeatureGates sets the feature gates of the configuration in the global feature gate of the extension.
+func (c *Config) ApplyFeatureGates() error {
+	return features.FeatureGate.SetFromMap(c.Config.FeatureGates)
+}
+
+// Apply sets the settings of the configuration for the OSC controller in the given ActuatorOptions.
+func (c *Config) Apply(opts *operatingsystemconfig.ActuatorOptions) {
+	opts.NodeTuning = c.Config.NodeTuning
+	opts.Containerd = c.Config.Containerd
+	opts.InPlaceUpdates = c.Config.InPlaceUpdates
+	opts.ProvisionHooks = c.Config.ProvisionHooks
+}
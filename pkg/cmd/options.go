@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	gardenlinuxvalidation "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/validation"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/controller/operatingsystemconfig"
+)
+
+const (
+	// UserDataFormatFlag is the name of the command line flag to specify the default format of the user data.
+	UserDataFormatFlag = "user-data-format"
+	// UserDataSizeLimitsFlag is the name of the command line flag to specify the size limits of the user data.
+	UserDataSizeLimitsFlag = "user-data-size-limits"
+	// CompressUserDataFlag is the name of the command line flag to specify whether user data is compressed.
+	CompressUserDataFlag = "compress-user-data"
+	// ContainerdV2MinOSVersionFlag is the name of the command line flag to specify the minimum machine image version
+	// which ships containerd 2.x.
+	ContainerdV2MinOSVersionFlag = "containerd-v2-min-os-version"
+	// ProvisionHooksConfigMapFlag is the name of the command line flag to specify the ConfigMap with the provisioning
+	// hooks.
+	ProvisionHooksConfigMapFlag = "provision-hooks-configmap"
+)
+
+// OperatingSystemConfigOptions are command line options for the OSC controller.
+type OperatingSystemConfigOptions struct {
+	// UserDataFormat is the format of the user data which provisions the nodes if the provider config of the
+	// OperatingSystemConfig does not specify one.
+	UserDataFormat string
+	// UserDataSizeLimits are the maximum sizes in bytes of the user data per provider type of the shoot.
+	UserDataSizeLimits map[string]int
+	// CompressUserData specifies whether user data which exceeds its size limit is compressed.
+	CompressUserData bool
+	// ContainerdV2MinOSVersion is the minimum machine image version which ships containerd 2.x.
+	ContainerdV2MinOSVersion string
+	// ProvisionHooksConfigMap is the name of the ConfigMap with further provisioning hooks, optionally prefixed with
+	// its namespace and a slash.
+	ProvisionHooksConfigMap string
+	// ProvisionHooksConfigMapNamespace is the namespace of the ConfigMap with the provisioning hooks if its name is not
+	// prefixed with one, usually the namespace of the extension.
+	ProvisionHooksConfigMapNamespace string
+
+	config *OperatingSystemConfigConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *OperatingSystemConfigOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.UserDataFormat, UserDataFormatFlag, string(apisgardenlinux.UserDataFormatBash), "Default format of the user data which provisions the nodes (bash, cloud-config, mime-multipart or ignition).")
+	fs.StringToIntVar(&o.UserDataSizeLimits, UserDataSizeLimitsFlag, nil, "Maximum sizes in bytes of the user data per provider type of the shoot, e.g. 'aws=16384'.")
+	fs.BoolVar(&o.CompressUserData, CompressUserDataFlag, false, "Compress user data which exceeds its size limit.")
+	fs.StringVar(&o.ContainerdV2MinOSVersion, ContainerdV2MinOSVersionFlag, "", "Minimum machine image version which ships containerd 2.x. If empty, containerd 1.x is assumed unless the provider config of a worker pool specifies the major version.")
+	fs.StringVar(&o.ProvisionHooksConfigMap, ProvisionHooksConfigMapFlag, "", "Name of a ConfigMap ([namespace/]name) whose key 'hooks.yaml' contains further provisioning hooks. A missing ConfigMap contains no hooks.")
+}
+
+// Complete implements Completer.Complete.
+func (o *OperatingSystemConfigOptions) Complete() error {
+	userDataFormat := apisgardenlinux.UserDataFormat(o.UserDataFormat)
+	if errs := gardenlinuxvalidation.ValidateUserDataFormat(userDataFormat, field.NewPath(UserDataFormatFlag)); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+
+	for providerType, limit := range o.UserDataSizeLimits {
+		if limit <= 0 {
+			return fmt.Errorf("user data size limit for provider type %q must be positive, got %d", providerType, limit)
+		}
+	}
+
+	if o.ContainerdV2MinOSVersion != "" {
+		if _, err := semver.NewVersion(o.ContainerdV2MinOSVersion); err != nil {
+			return fmt.Errorf("invalid minimum machine image version for containerd 2.x %q: %w", o.ContainerdV2MinOSVersion, err)
+		}
+	}
+
+	var provisionHooksConfigMap *types.NamespacedName
+	if o.ProvisionHooksConfigMap != "" {
+		namespace, name, found := strings.Cut(o.ProvisionHooksConfigMap, "/")
+		if !found {
+			namespace, name = o.ProvisionHooksConfigMapNamespace, o.ProvisionHooksConfigMap
+		}
+		if namespace == "" || name == "" {
+			return fmt.Errorf("invalid configmap with provisioning hooks %q, the namespace and the name must not be empty", o.ProvisionHooksConfigMap)
+		}
+		provisionHooksConfigMap = &types.NamespacedName{Namespace: namespace, Name: name}
+	}
+
+	o.config = &OperatingSystemConfigConfig{
+		UserDataFormat:           userDataFormat,
+		UserDataSizeLimits:       o.UserDataSizeLimits,
+		CompressUserData:         o.CompressUserData,
+		ContainerdV2MinOSVersion: o.ContainerdV2MinOSVersion,
+		ProvisionHooksConfigMap:  provisionHooksConfigMap,
+	}
+	return nil
+}
+
+// Completed returns the completed OperatingSystemConfigConfig. Only call this if `Complete` was successful.
+func (o *OperatingSystemConfigOptions) Completed() *OperatingSystemConfigConfig {
+	return o.config
+}
+
+// OperatingSystemConfigConfig is a completed OSC controller configuration.
+type OperatingSystemConfigConfig struct {
+	// UserDataFormat is the default format of the user data which provisions the nodes.
+	UserDataFormat apisgardenlinux.UserDataFormat
+	// UserDataSizeLimits are the maximum sizes in bytes of the user data per provider type of the shoot.
+	UserDataSizeLimits map[string]int
+	// CompressUserData specifies whether user data which exceeds its size limit is compressed.
+	CompressUserData bool
+	// ContainerdV2MinOSVersion is the minimum machine image version which ships containerd 2.x.
+	ContainerdV2MinOSVersion string
+	// ProvisionHooksConfigMap is the ConfigMap with further provisioning hooks.
+	ProvisionHooksConfigMap *types.NamespacedName
+}
+
+// Apply sets the values of this OperatingSystemConfigConfig in the given ActuatorOptions.
+func (c *OperatingSystemConfigConfig) Apply(opts *operatingsystemconfig.ActuatorOptions) {
+	opts.UserDataFormat = c.UserDataFormat
+	opts.UserDataSizeLimits = c.UserDataSizeLimits
+	opts.CompressUserData = c.CompressUserData
+	opts.ContainerdV2MinOSVersion = c.ContainerdV2MinOSVersion
+	opts.ProvisionHooksConfigMap = c.ProvisionHooksConfigMap
+}
@@ -8,48 +8,120 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"path"
 	"path/filepath"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	gardenlinuxv1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/v1alpha1"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/memoryone"
 )
 
 type actuator struct {
 	client client.Client
+
+	defaultUserDataFormat apisgardenlinux.UserDataFormat
+	userDataSizeLimits    map[string]int
+	compressUserData      bool
+
+	containerdV2MinOSVersion string
+
+	defaultKernelModules    []string
+	defaultSysctls          map[string]string
+	containerdLimitMEMLOCK  int64
+	containerdLimitNOFILE   int64
+	inPlaceUpdateScriptPath string
+
+	staticProvisionHooks    []config.ProvisionHook
+	provisionHooksConfigMap *types.NamespacedName
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled OperatingSystemConfig resources.
-func NewActuator(mgr manager.Manager) operatingsystemconfig.Actuator {
-	return &actuator{
-		client: mgr.GetClient(),
+func NewActuator(mgr manager.Manager, opts ActuatorOptions) operatingsystemconfig.Actuator {
+	return NewActuatorWithClient(mgr.GetClient(), opts)
+}
+
+// NewActuatorWithClient creates a new Actuator which reads referenced resources with the given client.
+func NewActuatorWithClient(c client.Client, opts ActuatorOptions) operatingsystemconfig.Actuator {
+	a := &actuator{
+		client:                c,
+		defaultUserDataFormat: opts.UserDataFormat,
+		userDataSizeLimits:    opts.UserDataSizeLimits,
+		compressUserData:      opts.CompressUserData,
+
+		containerdV2MinOSVersion: opts.ContainerdV2MinOSVersion,
+
+		provisionHooksConfigMap: opts.ProvisionHooksConfigMap,
 	}
+	a.applyControllerConfiguration(opts)
+
+	return a
+}
+
+func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, *extensionsv1alpha1.InPlaceUpdatesStatus, error) {
+	start := time.Now()
+	userData, extensionUnits, extensionFiles, inPlaceUpdates, err := a.reconcile(ctx, osc)
+	recordReconcile(osc, time.Since(start), err)
+
+	return userData, extensionUnits, extensionFiles, inPlaceUpdates, err
 }
 
-func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, *extensionsv1alpha1.InPlaceUpdatesStatus, error) {
+func (a *actuator) reconcile(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, *extensionsv1alpha1.InPlaceUpdatesStatus, error) {
+	config, err := gardenlinux.Configuration(osc)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if errs := validateUnits(osc.Spec.Units, field.NewPath("spec", "units")); len(errs) > 0 {
+		return nil, nil, nil, nil, fmt.Errorf("invalid units: %w", errs.ToAggregate())
+	}
+
 	switch purpose := osc.Spec.Purpose; purpose {
 	case extensionsv1alpha1.OperatingSystemConfigPurposeProvision:
-		userData, err := a.handleProvisionOSC(ctx, osc)
-		return []byte(userData), nil, nil, nil, err
+		userData, status, err := a.handleProvisionOSC(ctx, osc, config)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		return []byte(userData), nil, nil, nil, a.updateProviderStatus(ctx, osc, status)
 
 	case extensionsv1alpha1.OperatingSystemConfigPurposeReconcile:
-		extensionUnits, extensionFiles, inPlaceUpdates, err := a.handleReconcileOSC(osc)
-		return nil, extensionUnits, extensionFiles, inPlaceUpdates, err
+		extensionUnits, extensionFiles, inPlaceUpdates, err := a.handleReconcileOSC(ctx, osc, config)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+
+		status := &gardenlinuxv1alpha1.OperatingSystemConfigurationStatus{}
+		if inPlaceUpdates != nil {
+			status.InPlaceUpdates = &gardenlinuxv1alpha1.InPlaceUpdatesStatus{
+				ScriptPath:    a.inPlaceUpdateScriptPath,
+				ScriptVersion: scriptVersionInPlaceUpdate,
+			}
+		}
+		return nil, extensionUnits, extensionFiles, inPlaceUpdates, a.updateProviderStatus(ctx, osc, status)
 
 	default:
 		return nil, nil, nil, nil, fmt.Errorf("unknown purpose: %s", purpose)
 	}
 }
 
-func (a *actuator) Delete(_ context.Context, _ logr.Logger, _ *extensionsv1alpha1.OperatingSystemConfig) error {
+func (a *actuator) Delete(_ context.Context, _ logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	forgetMetrics(osc)
 	return nil
 }
 
@@ -65,85 +137,202 @@ func (a *actuator) Restore(ctx context.Context, log logr.Logger, osc *extensions
 	return a.Reconcile(ctx, log, osc)
 }
 
-func (a *actuator) handleProvisionOSC(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) (string, error) {
-	writeFilesToDiskScript, err := operatingsystemconfig.FilesToDiskScript(ctx, a.client, osc.Namespace, osc.Spec.Files)
+func (a *actuator) handleProvisionOSC(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, config *apisgardenlinux.OperatingSystemConfiguration) (string, *gardenlinuxv1alpha1.OperatingSystemConfigurationStatus, error) {
+	containerdMajorVersion, err := a.containerdMajorVersion(ctx, osc, config)
 	if err != nil {
-		return "", err
-	}
-	writeUnitsToDiskScript := operatingsystemconfig.UnitsToDiskScript(osc.Spec.Units)
-
-	script := `#!/bin/bash
-if [ ! -s /etc/containerd/config.toml ]; then
-  mkdir -p /etc/containerd/
-  containerd config default > /etc/containerd/config.toml
-  chmod 0644 /etc/containerd/config.toml
-fi
-
-mkdir -p /etc/systemd/system/containerd.service.d
-cat <<EOF > /etc/systemd/system/containerd.service.d/11-exec_config.conf
-[Service]
-ExecStart=
-ExecStart=/usr/bin/containerd --config=/etc/containerd/config.toml
-EOF
-chmod 0644 /etc/systemd/system/containerd.service.d/11-exec_config.conf
-` + writeFilesToDiskScript + `
-` + writeUnitsToDiskScript + `
-grep -sq "^nfsd$" /etc/modules || echo "nfsd" >>/etc/modules
-modprobe nfsd
-nslookup $(hostname) || systemctl restart systemd-networkd
-
-systemctl daemon-reload
-systemctl enable containerd && systemctl restart containerd
-`
-	for _, unit := range osc.Spec.Units {
-		script += fmt.Sprintf(`systemctl enable '%s' && systemctl restart --no-block '%s'
-`, unit.Name, unit.Name)
-	}
-
-	// The provisioning script must run only once.
-	script = operatingsystemconfig.WrapProvisionOSCIntoOneshotScript(script)
+		return "", nil, err
+	}
+
+	data, err := a.provisionData(ctx, osc, config, containerdMajorVersion)
+	if err != nil {
+		return "", nil, err
+	}
+	recordContent(osc, len(data.allFiles())+len(data.unitFiles()), len(data.units))
+
+	userData, err := a.renderUserData(osc, config, data, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	providerType, limit, err := a.userDataSizeLimit(ctx, osc)
+	if err != nil {
+		return "", nil, err
+	}
+	if limit == 0 || len(userData) <= limit {
+		status, err := a.provisionStatus(osc, config, userData, false)
+		return userData, status, err
+	}
+
+	if a.compressUserData {
+		if userData, err = a.renderUserData(osc, config, data, true); err != nil {
+			return "", nil, err
+		}
+		if len(userData) <= limit {
+			status, err := a.provisionStatus(osc, config, userData, true)
+			return userData, status, err
+		}
+	}
+
+	return "", nil, a.userDataSizeError(data, providerType, len(userData), limit)
+}
+
+// provisionStatus records the given user data and returns the provider status for it.
+func (a *actuator) provisionStatus(osc *extensionsv1alpha1.OperatingSystemConfig, config *apisgardenlinux.OperatingSystemConfiguration, userData string, compressed bool) (*gardenlinuxv1alpha1.OperatingSystemConfigurationStatus, error) {
+	format := a.userDataFormat(config)
+	recordUserData(osc, format, userData)
+
+	status := &gardenlinuxv1alpha1.OperatingSystemConfigurationStatus{
+		UserData: &gardenlinuxv1alpha1.UserDataStatus{
+			Format:     gardenlinuxv1alpha1.UserDataFormat(format),
+			Hash:       utils.ComputeSHA256Hex([]byte(userData)),
+			Size:       len(userData),
+			Compressed: compressed,
+		},
+	}
 
 	if osc.Spec.Type == memoryone.OSTypeMemoryOneGardenLinux {
-		return wrapIntoMemoryOneHeaderAndFooter(osc, script)
+		memoryOneConfig, err := memoryone.Configuration(osc)
+		if err != nil {
+			return nil, err
+		}
+		warnings, err := vsmpWarnings(memoryOneConfig)
+		if err != nil {
+			return nil, err
+		}
+		status.MemoryOne = &gardenlinuxv1alpha1.MemoryOneStatus{
+			VsmpConfiguration: vsmpParameters(memoryOneConfig),
+			Warnings:          warnings,
+		}
+	}
+
+	return status, nil
+}
+
+// userDataFormat returns the format of the user data for the given provider config.
+func (a *actuator) userDataFormat(config *apisgardenlinux.OperatingSystemConfiguration) apisgardenlinux.UserDataFormat {
+	if format := ptr.Deref(config.UserDataFormat, a.defaultUserDataFormat); format != "" {
+		return format
+	}
+	return apisgardenlinux.UserDataFormatBash
+}
+
+// renderUserData renders the given provisioning data as user data in the configured format. If compress is true, the
+// user data is compressed as far as the format allows.
+func (a *actuator) renderUserData(osc *extensionsv1alpha1.OperatingSystemConfig, config *apisgardenlinux.OperatingSystemConfiguration, data *provisionData, compress bool) (string, error) {
+	var (
+		parts []mimePart
+		err   error
+	)
+
+	switch format := a.userDataFormat(config); format {
+	case apisgardenlinux.UserDataFormatBash:
+		parts = []mimePart{{contentType: contentTypeShellScript, content: data.provisionScript()}}
+
+	case apisgardenlinux.UserDataFormatCloudConfig:
+		cloudConfig, err := data.cloudConfig(true)
+		if err != nil {
+			return "", err
+		}
+		parts = []mimePart{{contentType: contentTypeCloudConfig, content: cloudConfig}}
+
+	case apisgardenlinux.UserDataFormatMIMEMultipart:
+		cloudConfig, err := data.cloudConfig(false)
+		if err != nil {
+			return "", err
+		}
+		parts = []mimePart{
+			{contentType: contentTypeCloudConfig, content: cloudConfig},
+			{contentType: contentTypeShellScript, content: data.script()},
+		}
+
+	case apisgardenlinux.UserDataFormatIgnition:
+		if osc.Spec.Type == memoryone.OSTypeMemoryOneGardenLinux {
+			return "", fmt.Errorf("user data format %q is not supported for OS type %q", format, osc.Spec.Type)
+		}
+		return data.ignition(compress)
+
+	default:
+		return "", fmt.Errorf("unsupported user data format %q", format)
 	}
 
-	return script, nil
+	if compress {
+		if parts, err = compressParts(parts); err != nil {
+			return "", err
+		}
+	}
+
+	if osc.Spec.Type == memoryone.OSTypeMemoryOneGardenLinux {
+		return wrapIntoMemoryOneHeaderAndFooter(osc, parts...)
+	}
+
+	if len(parts) == 1 && parts[0].transferEncoding == "" {
+		return parts[0].content, nil
+	}
+
+	return mimeMultipart(parts...), nil
 }
 
-var scriptContentInPlaceUpdate []byte
+var (
+	scriptContentInPlaceUpdate []byte
+	// scriptVersionInPlaceUpdate is the version of the in-place update script, i.e. the SHA256 hash of its content.
+	scriptVersionInPlaceUpdate string
+)
 
 func init() {
 	var err error
 
 	scriptContentInPlaceUpdate, err = gardenlinux.Templates.ReadFile(filepath.Join("scripts", "inplace-update.sh"))
 	utilruntime.Must(err)
+	scriptVersionInPlaceUpdate = utils.ComputeSHA256Hex(scriptContentInPlaceUpdate)
 }
 
-func (a *actuator) handleReconcileOSC(osc *extensionsv1alpha1.OperatingSystemConfig) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, *extensionsv1alpha1.InPlaceUpdatesStatus, error) {
+func (a *actuator) handleReconcileOSC(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, config *apisgardenlinux.OperatingSystemConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, *extensionsv1alpha1.InPlaceUpdatesStatus, error) {
 	var (
 		extensionUnits []extensionsv1alpha1.Unit
 		extensionFiles []extensionsv1alpha1.File
 		inPlaceUpdates *extensionsv1alpha1.InPlaceUpdatesStatus
 	)
 
+	containerdMajorVersion, err := a.containerdMajorVersion(ctx, osc, config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	generation, err := containerdGenerationFor(containerdMajorVersion)
+	if err != nil {
+		return nil, nil, nil, v1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	// The exec drop-in is also written when provisioning the node. It is part of the reconciliation as well, so that it
+	// matches the major version of containerd after in-place updates.
 	extensionUnits = []extensionsv1alpha1.Unit{
 		{
-			Name: "containerd.service",
+			Name: unitNameContainerd,
 			DropIns: []extensionsv1alpha1.DropIn{
 				{
-					Name: "override.conf",
-					Content: `[Service]
-LimitMEMLOCK=67108864
-LimitNOFILE=1048576`,
+					Name:    path.Base(containerdExecConfigDropInPath),
+					Content: generation.execConfigDropIn,
+				},
+				{
+					Name:    containerdLimitsDropInName,
+					Content: generation.limitsDropIn(a.containerdLimitMEMLOCK, a.containerdLimitNOFILE),
 				},
 			},
 		},
 	}
 
+	tunings, err := a.tuning(ctx, osc, config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	extensionFiles = append(extensionFiles, tunings.files...)
+	extensionUnits = append(extensionUnits, tunings.units...)
+
+	var targetVersion string
 	if osc.Spec.InPlaceUpdates != nil {
-		filePathOSUpdateScript := filepath.Join(gardenlinux.ScriptLocation, "inplace-update.sh")
+		targetVersion = versionutils.Normalize(osc.Spec.InPlaceUpdates.OperatingSystemVersion)
+
 		extensionFiles = append(extensionFiles, extensionsv1alpha1.File{
-			Path: filePathOSUpdateScript,
+			Path: a.inPlaceUpdateScriptPath,
 			Content: extensionsv1alpha1.FileContent{
 				Inline: &extensionsv1alpha1.FileContentInline{
 					Data:     utils.EncodeBase64(scriptContentInPlaceUpdate),
@@ -155,11 +344,14 @@ LimitNOFILE=1048576`,
 
 		inPlaceUpdates = &extensionsv1alpha1.InPlaceUpdatesStatus{
 			OSUpdate: &extensionsv1alpha1.OSUpdate{
-				Command: filePathOSUpdateScript,
-				Args:    []string{versionutils.Normalize(osc.Spec.InPlaceUpdates.OperatingSystemVersion)},
+				Command: a.inPlaceUpdateScriptPath,
+				Args:    []string{targetVersion},
 			},
 		}
 	}
 
+	recordInPlaceUpdateTargetVersion(osc, targetVersion)
+	recordContent(osc, len(extensionFiles), len(extensionUnits))
+
 	return extensionUnits, extensionFiles, inPlaceUpdates, nil
 }
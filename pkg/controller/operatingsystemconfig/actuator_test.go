@@ -5,41 +5,96 @@
 package operatingsystemconfig_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
 	"io"
+	"math/big"
 	"mime"
 	"mime/multipart"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/test"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-		metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	. "github.com/onsi/gomega/gstruct"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
 	runtimeutils "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/yaml"
 
-		memoryonev1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/v1alpha1"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	gardenlinuxv1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/v1alpha1"
+	memoryonev1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/v1alpha1"
 	. "github.com/gardener/gardener-extension-os-gardenlinux/pkg/controller/operatingsystemconfig"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/features"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/memoryone"
 )
 
-var codec runtime.Codec
+var (
+	codec            runtime.Codec
+	gardenLinuxCodec runtime.Codec
+
+	waitForNetworkScript     []byte
+	provisionReportFunctions string
+	caBundle                 string
+)
 
 func init() {
 	scheme := runtime.NewScheme()
 	runtimeutils.Must(memoryonev1alpha1.AddToScheme(scheme))
+	runtimeutils.Must(gardenlinuxv1alpha1.AddToScheme(scheme))
 	codec = serializer.NewCodecFactory(scheme, serializer.EnableStrict).LegacyCodec(memoryonev1alpha1.SchemeGroupVersion)
-}
+	gardenLinuxCodec = serializer.NewCodecFactory(scheme, serializer.EnableStrict).LegacyCodec(gardenlinuxv1alpha1.SchemeGroupVersion)
+
+	var err error
+	waitForNetworkScript, err = gardenlinux.Templates.ReadFile("scripts/wait-for-network.sh")
+	runtimeutils.Must(err)
+
+	provisionReportScript, err := gardenlinux.Templates.ReadFile("scripts/provision-report.sh")
+	runtimeutils.Must(err)
+	var lines []string
+	for _, line := range strings.Split(string(provisionReportScript), "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			lines = append(lines, line)
+		}
+	}
+	provisionReportFunctions = strings.Join(lines, "\n")
 
+	caBundle, err = generateCACertificate()
+	runtimeutils.Must(err)
+}
 
 var _ = Describe("Actuator", func() {
 	var (
@@ -53,9 +108,9 @@ var _ = Describe("Actuator", func() {
 	)
 
 	BeforeEach(func() {
-		fakeClient = fakeclient.NewClientBuilder().Build()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.OperatingSystemConfig{}).Build()
 		mgr = test.FakeManager{Client: fakeClient}
-		actuator = NewActuator(mgr)
+		actuator = NewActuator(mgr, ActuatorOptions{})
 
 		osc = &extensionsv1alpha1.OperatingSystemConfig{
 			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
@@ -63,50 +118,82 @@ var _ = Describe("Actuator", func() {
 					Type: gardenlinux.OSTypeGardenLinux,
 				},
 				Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeProvision,
-				Units:   []extensionsv1alpha1.Unit{{Name: "some-unit", Content: ptr.To("foo")}},
+				Units:   []extensionsv1alpha1.Unit{{Name: "some-unit.service", Content: ptr.To("foo")}},
 				Files:   []extensionsv1alpha1.File{{Path: "/some/file", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "bar"}}}},
 			},
 		}
 	})
 
 	When("purpose is 'provision'", func() {
+		expectedContainerdConfig := `imports = ["/etc/containerd/conf.d/*.toml"]
+version = 2
+
+[plugins]
+  [plugins."io.containerd.grpc.v1.cri"]
+    [plugins."io.containerd.grpc.v1.cri".cni]
+      bin_dir = "/opt/cni/bin"
+    [plugins."io.containerd.grpc.v1.cri".containerd]
+      default_runtime_name = "runc"
+      [plugins."io.containerd.grpc.v1.cri".containerd.runtimes]
+        [plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runc]
+          runtime_type = "io.containerd.runc.v2"
+    [plugins."io.containerd.grpc.v1.cri".registry]
+      config_path = "/etc/containerd/certs.d"
+`
+
 		expectedUserData := `#!/bin/bash
 if [ -f "/var/lib/osc/provision-osc-applied" ]; then
   echo "Provision OSC already applied, exiting..."
   exit 0
 fi
 
-if [ ! -s /etc/containerd/config.toml ]; then
-  mkdir -p /etc/containerd/
-  containerd config default > /etc/containerd/config.toml
-  chmod 0644 /etc/containerd/config.toml
-fi
-
-mkdir -p /etc/systemd/system/containerd.service.d
-cat <<EOF > /etc/systemd/system/containerd.service.d/11-exec_config.conf
-[Service]
-ExecStart=
-ExecStart=/usr/bin/containerd --config=/etc/containerd/config.toml
-EOF
-chmod 0644 /etc/systemd/system/containerd.service.d/11-exec_config.conf
-
-mkdir -p "/some"
-
-cat << EOF | base64 -d > "/some/file"
+` + provisionReportFunctions + `
+provision_step_begin 'files'
+mkdir -p '/some'
+base64 -d > '/some/file' << 'EOF'
 YmFy
 EOF
-
-
-cat << EOF | base64 -d > "/etc/systemd/system/some-unit"
+mkdir -p '/etc/modules-load.d'
+base64 -d > '/etc/modules-load.d/gardener.conf' << 'EOF'
+bmZzZAo=
+EOF
+chmod 0644 '/etc/modules-load.d/gardener.conf'
+mkdir -p '/etc/containerd'
+base64 -d > '/etc/containerd/config.toml' << 'EOF'
+` + utils.EncodeBase64([]byte(expectedContainerdConfig)) + `
+EOF
+chmod 0644 '/etc/containerd/config.toml'
+mkdir -p '/opt/gardener/bin'
+base64 -d > '/opt/gardener/bin/wait-for-network.sh' << 'EOF'
+` + utils.EncodeBase64(waitForNetworkScript) + `
+EOF
+chmod 0755 '/opt/gardener/bin/wait-for-network.sh'
+mkdir -p '/etc/systemd/system/containerd.service.d'
+base64 -d > '/etc/systemd/system/containerd.service.d/11-exec_config.conf' << 'EOF'
+W1NlcnZpY2VdCkV4ZWNTdGFydD0KRXhlY1N0YXJ0PS91c3IvYmluL2NvbnRhaW5lcmQgLS1jb25maWc9L2V0Yy9jb250YWluZXJkL2NvbmZpZy50b21sCg==
+EOF
+chmod 0644 '/etc/systemd/system/containerd.service.d/11-exec_config.conf'
+mkdir -p '/etc/systemd/system'
+base64 -d > '/etc/systemd/system/some-unit.service' << 'EOF'
 Zm9v
 EOF
-grep -sq "^nfsd$" /etc/modules || echo "nfsd" >>/etc/modules
-modprobe nfsd
-nslookup $(hostname) || systemctl restart systemd-networkd
-
+chmod 0644 '/etc/systemd/system/some-unit.service'
+provision_step_end $?
+provision_step_begin 'kernel-modules'
+modprobe 'nfsd'
+provision_step_end $?
+provision_step_begin 'network'
+'/opt/gardener/bin/wait-for-network.sh' '--timeout' '300' '--retry-interval' '5' '--network-online' || exit 1
+provision_step_end $?
+provision_step_begin 'containerd'
 systemctl daemon-reload
-systemctl enable containerd && systemctl restart containerd
-systemctl enable 'some-unit' && systemctl restart --no-block 'some-unit'
+systemctl enable 'containerd.service'
+systemctl restart 'containerd.service'
+provision_step_end $?
+provision_step_begin 'units'
+systemctl enable 'some-unit.service'
+systemctl restart --no-block 'some-unit.service'
+provision_step_end $?
 
 
 mkdir -p /var/lib/osc
@@ -124,9 +211,931 @@ touch /var/lib/osc/provision-osc-applied
 					Expect(extensionFiles).To(BeEmpty())
 					Expect(inplaceUpdateStatus).To(BeNil())
 				})
+
+				It("should accept an empty provider config", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{})).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(userData)).To(Equal(expectedUserData))
+				})
+
+				It("should write and apply the configured sysctls", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Sysctls: &gardenlinuxv1alpha1.Sysctls{
+							Parameters: map[string]string{"vm.max_map_count": "135217728"},
+						},
+					})).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(userData)).To(ContainSubstring(`base64 -d > '/etc/sysctl.d/99-gardener.conf' << 'EOF'
+dm0ubWF4X21hcF9jb3VudCA9IDEzNTIxNzcyOAo=
+EOF`))
+					Expect(string(userData)).To(ContainSubstring(`
+sysctl --system
+`))
+				})
+
+				It("should write and apply the network configuration before waiting for the network", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Network: &gardenlinuxv1alpha1.Network{
+							Interfaces: []gardenlinuxv1alpha1.NetworkInterface{{
+								Name:  "primary",
+								Match: gardenlinuxv1alpha1.NetworkMatch{Names: []string{"eth0"}},
+								MTU:   ptr.To[int32](1460),
+							}},
+						},
+					})).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(filesFromScript(string(userData))).To(HaveKeyWithValue("/etc/systemd/network/10-gardener-primary.network", "[Match]\nName=eth0\n\n[Link]\nMTUBytes=1460\n\n[Network]\nDHCP=yes\n"))
+					Expect(string(userData)).To(ContainSubstring("\nprovision_step_begin 'network-configuration'\nnetworkctl reload\nprovision_step_end $?\nprovision_step_begin 'network'\n'/opt/gardener/bin/wait-for-network.sh' "))
+				})
+
+				It("should return an error if the network configuration is invalid", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Network: &gardenlinuxv1alpha1.Network{
+							Interfaces: []gardenlinuxv1alpha1.NetworkInterface{{
+								Name:  "primary",
+								Match: gardenlinuxv1alpha1.NetworkMatch{Names: []string{"eth0\n[Network]"}},
+							}},
+						},
+					})).To(Succeed())
+
+					_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).To(MatchError(ContainSubstring("spec.providerConfig.network.interfaces[0].match.names[0]")))
+				})
+
+				It("should write and start the swap unit", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Swap: &gardenlinuxv1alpha1.Swap{Zram: &gardenlinuxv1alpha1.Zram{Size: resource.MustParse("1Gi"), CompressionAlgorithm: ptr.To("lz4")}},
+					})).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					files := filesFromScript(string(userData))
+					Expect(files).To(HaveKeyWithValue("/opt/gardener/bin/setup-swap.sh", Not(BeEmpty())))
+					Expect(files).To(HaveKeyWithValue("/etc/systemd/system/gardener-swap.service", ContainSubstring("\nExecStart=/opt/gardener/bin/setup-swap.sh zram 1073741824 lz4\n")))
+					Expect(string(userData)).To(ContainSubstring("\nsystemctl restart --no-block 'some-unit.service'\nsystemctl enable 'gardener-swap.service'\nsystemctl restart --no-block 'gardener-swap.service'\n"))
+				})
+
+				It("should configure and start the NTP daemon", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						NTP: &gardenlinuxv1alpha1.NTP{
+							Daemon:  ptr.To(gardenlinuxv1alpha1.NTPDaemonChrony),
+							Servers: []string{"10.0.0.123"},
+						},
+					})).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					files := filesFromScript(string(userData))
+					Expect(files).To(HaveKeyWithValue("/etc/chrony/gardener.conf", HavePrefix("server 10.0.0.123 iburst\n")))
+					Expect(files).To(HaveKeyWithValue("/etc/systemd/system/chrony.service.d/10-gardener-config.conf", ContainSubstring("-f /etc/chrony/gardener.conf")))
+					Expect(string(userData)).To(ContainSubstring(`
+systemctl enable 'chrony.service'
+systemctl restart --no-block 'chrony.service'
+systemctl disable 'systemd-timesyncd.service'
+systemctl stop --no-block 'systemd-timesyncd.service'
+`))
+				})
+
+				It("should only enable or disable units without content or drop-ins in an Ignition config", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						UserDataFormat: ptr.To(gardenlinuxv1alpha1.UserDataFormatIgnition),
+						NTP: &gardenlinuxv1alpha1.NTP{
+							Daemon:  ptr.To(gardenlinuxv1alpha1.NTPDaemonChrony),
+							Servers: []string{"10.0.0.123"},
+						},
+					})).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(string(userData)).To(ContainSubstring(`"name":"chrony.service"`))
+					Expect(string(userData)).NotTo(ContainSubstring(`"name":"systemd-timesyncd.service"`))
+					files, _, _ := decodeIgnition(string(userData))
+					Expect(files["/opt/gardener/bin/provision-osc.sh"]).To(ContainSubstring("\nsystemctl disable 'systemd-timesyncd.service'\n"))
+				})
+
+				It("should update the trust store before waiting for the network and starting containerd", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						CABundles: []gardenlinuxv1alpha1.CABundle{{Name: "corporate", Inline: ptr.To(caBundle)}},
+					})).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					files := filesFromScript(string(userData))
+					Expect(files).To(HaveKeyWithValue("/usr/local/share/ca-certificates/gardener-corporate.crt", caBundle))
+					Expect(files).To(HaveKeyWithValue("/opt/gardener/bin/update-ca-certificates.sh", Not(BeEmpty())))
+					Expect(files).To(HaveKeyWithValue("/etc/systemd/system/gardener-ca-certificates.service", ContainSubstring("\nBefore=containerd.service\n")))
+					Expect(string(userData)).To(ContainSubstring("\nprovision_step_begin 'ca-certificates'\n/opt/gardener/bin/update-ca-certificates.sh\nprovision_step_end $?\nprovision_step_begin 'network'\n'/opt/gardener/bin/wait-for-network.sh' "))
+				})
+
+				It("should mount the local storage before waiting for the network and starting containerd", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						LocalStorage: &gardenlinuxv1alpha1.LocalStorage{Disks: gardenlinuxv1alpha1.DiskSelector{Paths: []string{"/dev/nvme*n1"}}},
+					})).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					files := filesFromScript(string(userData))
+					Expect(files).To(HaveKeyWithValue("/opt/gardener/bin/setup-local-storage.sh", Not(BeEmpty())))
+					Expect(files).To(HaveKeyWithValue("/etc/gardener/local-storage.conf", ContainSubstring("PATHS=('/dev/nvme*n1')\n")))
+					Expect(files).To(HaveKey("/etc/systemd/system/gardener-local-storage.service"))
+					Expect(files).To(HaveKey(`/etc/systemd/system/mnt-local\x2dstorage.mount`))
+					Expect(files).To(HaveKey("/etc/systemd/system/var-lib-containerd.mount"))
+					Expect(files).To(HaveKey("/etc/systemd/system/var-lib-kubelet.mount"))
+					Expect(string(userData)).To(ContainSubstring(`
+provision_step_begin 'local-storage'
+systemctl daemon-reload
+systemctl enable 'gardener-local-storage.service'
+systemctl enable 'mnt-local\x2dstorage.mount'
+systemctl enable 'var-lib-containerd.mount'
+systemctl enable 'var-lib-kubelet.mount'
+'systemctl' 'start' 'var-lib-containerd.mount' 'var-lib-kubelet.mount' || exit 1
+provision_step_end $?
+provision_step_begin 'network'
+`))
+					Expect(string(userData)).NotTo(ContainSubstring("systemctl restart --no-block 'var-lib-containerd.mount'"))
+				})
+
+				Context("Provisioning hooks", func() {
+					var configMapKey = types.NamespacedName{Namespace: "extension", Name: "provision-hooks"}
+
+					BeforeEach(func() {
+						osc.Labels = map[string]string{v1beta1constants.LabelWorkerPool: "gpu"}
+						actuator = NewActuator(mgr, ActuatorOptions{
+							ProvisionHooks: []config.ProvisionHook{
+								{Name: "enroll-agent", Point: config.ProvisionHookPointPre, Script: "enroll-agent --site example\n"},
+								{Name: "register", Point: config.ProvisionHookPointPost, OSTypes: []string{gardenlinux.OSTypeGardenLinux}, Script: "#!/bin/sh\nregister-node\n"},
+								{Name: "other-pool", Point: config.ProvisionHookPointPre, WorkerPools: []string{"cpu"}, Script: "true\n"},
+								{Name: "memoryone", Point: config.ProvisionHookPointPre, OSTypes: []string{memoryone.OSTypeMemoryOneGardenLinux}, Script: "true\n"},
+							},
+							ProvisionHooksConfigMap: &configMapKey,
+						})
+					})
+
+					It("should run the matching hooks before writing the files and after starting the units", func() {
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						files := filesFromScript(string(userData))
+						Expect(files).To(HaveKeyWithValue("/opt/gardener/hooks/enroll-agent.sh", "#!/bin/bash\nenroll-agent --site example\n"))
+						Expect(files).To(HaveKeyWithValue("/opt/gardener/hooks/register.sh", "#!/bin/sh\nregister-node\n"))
+						Expect(files).NotTo(HaveKey("/opt/gardener/hooks/other-pool.sh"))
+						Expect(files).NotTo(HaveKey("/opt/gardener/hooks/memoryone.sh"))
+						Expect(string(userData)).To(ContainSubstring("chmod 0755 '/opt/gardener/hooks/enroll-agent.sh'\n"))
+						Expect(string(userData)).To(ContainSubstring(`chmod 0755 '/opt/gardener/hooks/enroll-agent.sh'
+provision_step_begin 'hook-enroll-agent'
+'/opt/gardener/hooks/enroll-agent.sh' || exit 1
+provision_step_end $?
+provision_step_begin 'files'
+`))
+						Expect(string(userData)).To(ContainSubstring(`systemctl restart --no-block 'some-unit.service'
+provision_step_end $?
+provision_step_begin 'hook-register'
+'/opt/gardener/hooks/register.sh' || exit 1
+provision_step_end $?
+`))
+					})
+
+					It("should run the pre hooks before writing any other file", func() {
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						script := string(userData)
+						preHook := strings.Index(script, "'/opt/gardener/hooks/enroll-agent.sh' || exit 1\n")
+						Expect(preHook).To(BeNumerically(">", 0))
+						Expect(strings.Count(script[:preHook], "base64 -d >")).To(Equal(1), "only the script of the pre hook is written before it runs")
+						Expect(script[:preHook]).To(ContainSubstring("'/opt/gardener/hooks/enroll-agent.sh'"))
+						Expect(script[preHook:]).To(ContainSubstring("/opt/gardener/hooks/register.sh"))
+					})
+
+					It("should add the hooks of the configmap", func() {
+						Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{Namespace: configMapKey.Namespace, Name: configMapKey.Name},
+							Data: map[string]string{ProvisionHooksConfigMapKey: `hooks:
+- name: inventory
+  point: post
+  workerPools: [gpu]
+  script: register-inventory
+`},
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(filesFromScript(string(userData))).To(HaveKeyWithValue("/opt/gardener/hooks/inventory.sh", "#!/bin/bash\nregister-inventory"))
+						Expect(string(userData)).To(ContainSubstring(`'/opt/gardener/hooks/register.sh' || exit 1
+provision_step_end $?
+provision_step_begin 'hook-inventory'
+'/opt/gardener/hooks/inventory.sh' || exit 1
+provision_step_end $?
+`))
+					})
+
+					It("should fail if the hooks of the configmap are invalid", func() {
+						Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{Namespace: configMapKey.Namespace, Name: configMapKey.Name},
+							Data:       map[string]string{ProvisionHooksConfigMapKey: "hooks:\n- name: Inventory\n  point: later\n"},
+						})).To(Succeed())
+
+						_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).To(MatchError(And(
+							ContainSubstring("configmap extension/provision-hooks: invalid provisioning hooks"),
+							ContainSubstring(`hooks[0].name: Invalid value: "Inventory"`),
+							ContainSubstring(`hooks[0].point: Unsupported value: "later"`),
+							ContainSubstring("hooks[0].script: Required value"),
+						)))
+					})
+
+					It("should fail if a hook of the configmap is already defined by the extension", func() {
+						Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{Namespace: configMapKey.Namespace, Name: configMapKey.Name},
+							Data:       map[string]string{ProvisionHooksConfigMapKey: "hooks:\n- name: register\n  point: pre\n  script: 'true'\n"},
+						})).To(Succeed())
+
+						_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).To(MatchError(ContainSubstring(`provisioning hook "register" is already defined`)))
+					})
+
+					It("should reject unknown fields in the configmap", func() {
+						Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{Namespace: configMapKey.Namespace, Name: configMapKey.Name},
+							Data:       map[string]string{ProvisionHooksConfigMapKey: "hooks:\n- name: foo\n  point: pre\n  script: 'true'\n  when: always\n"},
+						})).To(Succeed())
+
+						_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).To(MatchError(ContainSubstring(`unknown field "when"`)))
+					})
+				})
+
+				Context("Controller configuration", func() {
+					It("should load the default kernel modules of the extension if the provider config does not configure any", func() {
+						actuator = NewActuator(mgr, ActuatorOptions{NodeTuning: &config.NodeTuning{KernelModules: []string{"br_netfilter", "overlay"}}})
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(filesFromScript(string(userData))).To(HaveKeyWithValue("/etc/modules-load.d/gardener.conf", "br_netfilter\noverlay\n"))
+						Expect(string(userData)).To(ContainSubstring("\nmodprobe 'br_netfilter'\nmodprobe 'overlay'\n"))
+						Expect(string(userData)).NotTo(ContainSubstring("nfsd"))
+					})
+
+					It("should not load any kernel module if the extension configures none", func() {
+						actuator = NewActuator(mgr, ActuatorOptions{NodeTuning: &config.NodeTuning{KernelModules: []string{}}})
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(string(userData)).NotTo(ContainSubstring("modprobe"))
+					})
+
+					It("should prefer the kernel modules of the provider config", func() {
+						actuator = NewActuator(mgr, ActuatorOptions{NodeTuning: &config.NodeTuning{KernelModules: []string{"overlay"}}})
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							KernelModules: &gardenlinuxv1alpha1.KernelModules{Load: []string{"nfsd"}},
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(filesFromScript(string(userData))).To(HaveKeyWithValue("/etc/modules-load.d/gardener.conf", "nfsd\n"))
+					})
+
+					It("should not report the provisioning steps if the feature gate is disabled", func() {
+						DeferCleanup(test.WithFeatureGate(features.FeatureGate, features.ProvisionReport, false))
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(string(userData)).NotTo(ContainSubstring("provision_step"))
+						Expect(string(userData)).NotTo(ContainSubstring(provisionReportFunctions))
+						Expect(string(userData)).To(ContainSubstring("chmod 0644 '/etc/systemd/system/some-unit.service'\nmodprobe 'nfsd'\n'/opt/gardener/bin/wait-for-network.sh' "))
+					})
+				})
+
+				It("should return an error if the provider config cannot be decoded", func() {
+					osc.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"Foo"}`)}
+
+					_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).To(MatchError(ContainSubstring("failed to decode provider config")))
+				})
+
+				It("should reject invalid unit names", func() {
+					osc.Spec.Units = append(osc.Spec.Units,
+						extensionsv1alpha1.Unit{Name: "foo'; reboot; '.service"},
+						extensionsv1alpha1.Unit{Name: "no-suffix"},
+						extensionsv1alpha1.Unit{Name: "other.service", DropIns: []extensionsv1alpha1.DropIn{{Name: "$(reboot).conf"}}},
+					)
+
+					_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).To(MatchError(And(
+						ContainSubstring("invalid units"),
+						ContainSubstring(`spec.units[1].name: Invalid value: "foo'; reboot; '.service"`),
+						ContainSubstring(`spec.units[2].name: Invalid value: "no-suffix"`),
+						ContainSubstring(`spec.units[3].dropIns[0].name: Invalid value: "$(reboot).conf"`),
+					)))
+				})
+
+				It("should quote template unit names", func() {
+					osc.Spec.Units = append(osc.Spec.Units, extensionsv1alpha1.Unit{Name: "getty@tty1.service"})
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(userData)).To(ContainSubstring("systemctl enable 'getty@tty1.service'\nsystemctl restart --no-block 'getty@tty1.service'\n"))
+				})
+
+				DescribeTable("should honor the enable flag and the command of the units",
+					func(enable *bool, command *extensionsv1alpha1.UnitCommand, expectedCommands string) {
+						osc.Spec.Units[0].Enable = enable
+						osc.Spec.Units[0].Command = command
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(userData)).To(ContainSubstring("systemctl restart 'containerd.service'\nprovision_step_end $?\nprovision_step_begin 'units'\n" + expectedCommands + "\nprovision_step_end $?\n"))
+					},
+
+					Entry("default", nil, nil, "systemctl enable 'some-unit.service'\nsystemctl restart --no-block 'some-unit.service'"),
+					Entry("enabled", ptr.To(true), nil, "systemctl enable 'some-unit.service'\nsystemctl restart --no-block 'some-unit.service'"),
+					Entry("not enabled", ptr.To(false), nil, "systemctl disable 'some-unit.service'\nsystemctl stop --no-block 'some-unit.service'"),
+					Entry("command start", nil, ptr.To(extensionsv1alpha1.CommandStart), "systemctl enable 'some-unit.service'\nsystemctl restart --no-block 'some-unit.service'"),
+					Entry("command restart", nil, ptr.To(extensionsv1alpha1.CommandRestart), "systemctl enable 'some-unit.service'\nsystemctl restart --no-block 'some-unit.service'"),
+					Entry("command stop", nil, ptr.To(extensionsv1alpha1.CommandStop), "systemctl enable 'some-unit.service'\nsystemctl stop --no-block 'some-unit.service'"),
+					Entry("enabled with command start", ptr.To(true), ptr.To(extensionsv1alpha1.CommandStart), "systemctl enable 'some-unit.service'\nsystemctl restart --no-block 'some-unit.service'"),
+					Entry("enabled with command restart", ptr.To(true), ptr.To(extensionsv1alpha1.CommandRestart), "systemctl enable 'some-unit.service'\nsystemctl restart --no-block 'some-unit.service'"),
+					Entry("enabled with command stop", ptr.To(true), ptr.To(extensionsv1alpha1.CommandStop), "systemctl enable 'some-unit.service'\nsystemctl stop --no-block 'some-unit.service'"),
+					Entry("not enabled with command start", ptr.To(false), ptr.To(extensionsv1alpha1.CommandStart), "systemctl disable 'some-unit.service'\nsystemctl stop --no-block 'some-unit.service'"),
+					Entry("not enabled with command restart", ptr.To(false), ptr.To(extensionsv1alpha1.CommandRestart), "systemctl disable 'some-unit.service'\nsystemctl stop --no-block 'some-unit.service'"),
+					Entry("not enabled with command stop", ptr.To(false), ptr.To(extensionsv1alpha1.CommandStop), "systemctl disable 'some-unit.service'\nsystemctl stop --no-block 'some-unit.service'"),
+				)
+
+				It("should reject unknown unit commands", func() {
+					osc.Spec.Units[0].Command = ptr.To(extensionsv1alpha1.UnitCommand("reload"))
+
+					_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).To(MatchError(ContainSubstring(`spec.units[0].command: Unsupported value: "reload"`)))
+				})
+
+				Context("Network readiness", func() {
+					It("should wait for the configured checks", func() {
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							NetworkReadiness: &gardenlinuxv1alpha1.NetworkReadiness{
+								NetworkOnline:   ptr.To(false),
+								ResolveHostname: ptr.To(false),
+								Endpoints:       []string{"10.0.0.1:443", "registry.example.com:5000"},
+								Timeout:         &metav1.Duration{Duration: 90 * time.Second},
+								RetryInterval:   &metav1.Duration{Duration: 2 * time.Second},
+							},
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(userData)).To(ContainSubstring("\nprovision_step_begin 'network'\n'/opt/gardener/bin/wait-for-network.sh' '--timeout' '90' '--retry-interval' '2' '--endpoint' '10.0.0.1:443' '--endpoint' 'registry.example.com:5000' || exit 1\nprovision_step_end $?\n"))
+						Expect(filesFromScript(string(userData))).To(HaveKeyWithValue("/opt/gardener/bin/wait-for-network.sh", string(waitForNetworkScript)))
+					})
+
+					It("should wait with the default checks if the provider config does not configure any", func() {
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							NetworkReadiness: &gardenlinuxv1alpha1.NetworkReadiness{Endpoints: []string{"[fd00::1]:443"}},
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(userData)).To(ContainSubstring("\n'/opt/gardener/bin/wait-for-network.sh' '--timeout' '300' '--retry-interval' '5' '--network-online' '--endpoint' '[fd00::1]:443' || exit 1\n"))
+					})
+
+					It("should only require the hostname to be resolvable if it is enabled", func() {
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							NetworkReadiness: &gardenlinuxv1alpha1.NetworkReadiness{ResolveHostname: ptr.To(true)},
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(userData)).To(ContainSubstring("\n'/opt/gardener/bin/wait-for-network.sh' '--timeout' '300' '--retry-interval' '5' '--network-online' '--resolve-hostname' || exit 1\n"))
+					})
+
+					It("should fail if the network readiness configuration is invalid", func() {
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							NetworkReadiness: &gardenlinuxv1alpha1.NetworkReadiness{Endpoints: []string{"$(reboot):443"}},
+						})).To(Succeed())
+
+						_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).To(MatchError(ContainSubstring("spec.providerConfig.networkReadiness.endpoints[0]")))
+					})
+				})
+
+				Context("Containerd configuration", func() {
+					It("should render the containerd configuration from the CRI configuration", func() {
+						osc.Spec.CRIConfig = &extensionsv1alpha1.CRIConfig{
+							Name:         extensionsv1alpha1.CRINameContainerD,
+							CgroupDriver: ptr.To(extensionsv1alpha1.CgroupDriverSystemd),
+							Containerd: &extensionsv1alpha1.ContainerdConfig{
+								SandboxImage: "registry.k8s.io/pause:3.10",
+								Plugins: []extensionsv1alpha1.PluginConfig{
+									{
+										Path:   []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "runsc"},
+										Values: &apiextensionsv1.JSON{Raw: []byte(`{"runtime_type":"io.containerd.runsc.v1","options":{"TypeUrl":"io.containerd.runsc.v1.options","Priority":10}}`)},
+									},
+									{
+										Op:   ptr.To(extensionsv1alpha1.RemovePluginPathOperation),
+										Path: []string{"io.containerd.grpc.v1.cri", "cni"},
+									},
+									{
+										Path:   []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "runc", "options"},
+										Values: &apiextensionsv1.JSON{Raw: []byte(`{"BinaryName":"/usr/bin/runc"}`)},
+									},
+								},
+							},
+						}
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(filesFromScript(string(userData))).To(HaveKeyWithValue("/etc/containerd/config.toml", `imports = ["/etc/containerd/conf.d/*.toml"]
+version = 2
+
+[plugins]
+  [plugins."io.containerd.grpc.v1.cri"]
+    sandbox_image = "registry.k8s.io/pause:3.10"
+    [plugins."io.containerd.grpc.v1.cri".containerd]
+      default_runtime_name = "runc"
+      [plugins."io.containerd.grpc.v1.cri".containerd.runtimes]
+        [plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runc]
+          runtime_type = "io.containerd.runc.v2"
+          [plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runc.options]
+            BinaryName = "/usr/bin/runc"
+            SystemdCgroup = true
+        [plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runsc]
+          runtime_type = "io.containerd.runsc.v1"
+          [plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runsc.options]
+            Priority = 10
+            TypeUrl = "io.containerd.runsc.v1.options"
+    [plugins."io.containerd.grpc.v1.cri".registry]
+      config_path = "/etc/containerd/certs.d"
+`))
+					})
+
+					It("should render the containerd configuration deterministically", func() {
+						osc.Spec.CRIConfig = &extensionsv1alpha1.CRIConfig{
+							Name:         extensionsv1alpha1.CRINameContainerD,
+							CgroupDriver: ptr.To(extensionsv1alpha1.CgroupDriverCgroupfs),
+							Containerd:   &extensionsv1alpha1.ContainerdConfig{SandboxImage: "registry.k8s.io/pause:3.10"},
+						}
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(filesFromScript(string(userData))["/etc/containerd/config.toml"]).To(ContainSubstring("SystemdCgroup = false\n"))
+
+						for range 20 {
+							again, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(again).To(Equal(userData))
+						}
+					})
+
+					It("should write the host configurations of registries without readiness probe", func() {
+						osc.Spec.CRIConfig = &extensionsv1alpha1.CRIConfig{
+							Name: extensionsv1alpha1.CRINameContainerD,
+							Containerd: &extensionsv1alpha1.ContainerdConfig{
+								Registries: []extensionsv1alpha1.RegistryConfig{
+									{
+										Upstream: "docker.io",
+										Server:   ptr.To("https://registry-1.docker.io"),
+										Hosts: []extensionsv1alpha1.RegistryHost{
+											{URL: "https://mirror-b.example.com"},
+											{URL: "https://mirror-a.example.com", Capabilities: []extensionsv1alpha1.RegistryCapability{extensionsv1alpha1.PullCapability}, CACerts: []string{"/etc/ssl/mirror.pem"}},
+										},
+									},
+									{
+										Upstream:       "in-cluster.local",
+										Hosts:          []extensionsv1alpha1.RegistryHost{{URL: "http://10.0.0.1:5000"}},
+										ReadinessProbe: ptr.To(true),
+									},
+								},
+							},
+						}
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						files := filesFromScript(string(userData))
+						Expect(files).To(HaveKeyWithValue("/etc/containerd/certs.d/docker.io/hosts.toml", `# managed by gardener-node-agent
+server = "https://registry-1.docker.io"
+
+[host."https://mirror-b.example.com"]
+  capabilities = ["pull","resolve"]
+
+[host."https://mirror-a.example.com"]
+  capabilities = ["pull"]
+  ca = ["/etc/ssl/mirror.pem"]
+
+`))
+						Expect(files).NotTo(HaveKey("/etc/containerd/certs.d/in-cluster.local/hosts.toml"))
+					})
+
+					It("should return a configuration error for unsupported plugin operations", func() {
+						osc.Spec.CRIConfig = &extensionsv1alpha1.CRIConfig{
+							Name: extensionsv1alpha1.CRINameContainerD,
+							Containerd: &extensionsv1alpha1.ContainerdConfig{
+								Plugins: []extensionsv1alpha1.PluginConfig{{Op: ptr.To(extensionsv1alpha1.PluginPathOperation("replace")), Path: []string{"foo"}}},
+							},
+						}
+
+						_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).To(MatchError(ContainSubstring(`operation "replace" is not supported`)))
+						Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+					})
+				})
+
+				Context("Containerd 2.x", func() {
+					BeforeEach(func() {
+						osc.Spec.CRIConfig = &extensionsv1alpha1.CRIConfig{
+							Name:         extensionsv1alpha1.CRINameContainerD,
+							CgroupDriver: ptr.To(extensionsv1alpha1.CgroupDriverSystemd),
+							Containerd: &extensionsv1alpha1.ContainerdConfig{
+								SandboxImage: "registry.k8s.io/pause:3.10",
+								Plugins: []extensionsv1alpha1.PluginConfig{{
+									Path:   []string{"io.containerd.grpc.v1.cri", "containerd", "runtimes", "runsc"},
+									Values: &apiextensionsv1.JSON{Raw: []byte(`{"runtime_type":"io.containerd.runsc.v1"}`)},
+								}},
+							},
+						}
+					})
+
+					expectedContainerd2Config := `imports = ["/etc/containerd/conf.d/*.toml"]
+version = 3
+
+[plugins]
+  [plugins."io.containerd.cri.v1.images"]
+    [plugins."io.containerd.cri.v1.images".pinned_images]
+      sandbox = "registry.k8s.io/pause:3.10"
+    [plugins."io.containerd.cri.v1.images".registry]
+      config_path = "/etc/containerd/certs.d"
+  [plugins."io.containerd.cri.v1.runtime"]
+    [plugins."io.containerd.cri.v1.runtime".cni]
+      bin_dir = "/opt/cni/bin"
+    [plugins."io.containerd.cri.v1.runtime".containerd]
+      default_runtime_name = "runc"
+      [plugins."io.containerd.cri.v1.runtime".containerd.runtimes]
+        [plugins."io.containerd.cri.v1.runtime".containerd.runtimes.runc]
+          runtime_type = "io.containerd.runc.v2"
+          [plugins."io.containerd.cri.v1.runtime".containerd.runtimes.runc.options]
+            SystemdCgroup = true
+        [plugins."io.containerd.cri.v1.runtime".containerd.runtimes.runsc]
+          runtime_type = "io.containerd.runsc.v1"
+`
+
+					It("should render the configuration of version 3 if the provider config specifies containerd 2.x", func() {
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							Containerd: &gardenlinuxv1alpha1.Containerd{MajorVersion: ptr.To[int32](2)},
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(filesFromScript(string(userData))).To(HaveKeyWithValue("/etc/containerd/config.toml", expectedContainerd2Config))
+						Expect(string(userData)).To(ContainSubstring("systemctl enable 'containerd.service'\nsystemctl restart 'containerd.service'\n"))
+						Expect(filesFromScript(string(userData))).To(HaveKeyWithValue("/etc/systemd/system/containerd.service.d/11-exec_config.conf", "[Service]\nExecStart=\nExecStart=/usr/bin/containerd --config=/etc/containerd/config.toml\n"))
+					})
+
+					Context("detection", func() {
+						BeforeEach(func() {
+							fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&extensionsv1alpha1.Cluster{
+								ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+								Spec: extensionsv1alpha1.ClusterSpec{
+									Shoot: runtime.RawExtension{Raw: []byte(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","spec":{"provider":{"type":"aws","workers":[` +
+										`{"name":"old","machine":{"type":"m5.large","image":{"name":"gardenlinux","version":"1877.3.0"}}},` +
+										`{"name":"new","machine":{"type":"m5.large","image":{"name":"gardenlinux","version":"2000.0.0"}}}]}}}`)},
+								},
+							}).Build()
+							mgr = test.FakeManager{Client: fakeClient}
+							actuator = NewActuator(mgr, ActuatorOptions{ContainerdV2MinOSVersion: "2000.0"})
+							osc.Namespace = "shoot--foo--bar"
+						})
+
+						It("should render the configuration of version 3 for worker pools with a machine image version shipping containerd 2.x", func() {
+							osc.Labels = map[string]string{"worker.gardener.cloud/pool": "new"}
+
+							userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(filesFromScript(string(userData))).To(HaveKeyWithValue("/etc/containerd/config.toml", expectedContainerd2Config))
+						})
+
+						It("should render the configuration of version 2 for worker pools with an older machine image version", func() {
+							osc.Labels = map[string]string{"worker.gardener.cloud/pool": "old"}
+
+							userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(filesFromScript(string(userData))["/etc/containerd/config.toml"]).To(HavePrefix("imports = [\"/etc/containerd/conf.d/*.toml\"]\nversion = 2\n"))
+						})
+
+						It("should prefer the major version of the provider config", func() {
+							osc.Labels = map[string]string{"worker.gardener.cloud/pool": "new"}
+							Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+								Containerd: &gardenlinuxv1alpha1.Containerd{MajorVersion: ptr.To[int32](1)},
+							})).To(Succeed())
+
+							userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(filesFromScript(string(userData))["/etc/containerd/config.toml"]).To(ContainSubstring("version = 2\n"))
+						})
+
+						It("should assume containerd 1.x for unknown worker pools", func() {
+							osc.Labels = map[string]string{"worker.gardener.cloud/pool": "unknown"}
+
+							userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(filesFromScript(string(userData))["/etc/containerd/config.toml"]).To(ContainSubstring("version = 2\n"))
+						})
+					})
+				})
+
+				Context("User data size limits", func() {
+					var largeFileContent string
+
+					BeforeEach(func() {
+						fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&extensionsv1alpha1.Cluster{
+							ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+							Spec: extensionsv1alpha1.ClusterSpec{
+								Shoot: runtime.RawExtension{Raw: []byte(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","spec":{"provider":{"type":"aws"}}}`)},
+							},
+						}).Build()
+						mgr = test.FakeManager{Client: fakeClient}
+						osc.Namespace = "shoot--foo--bar"
+
+						largeFileContent = strings.Repeat("large file content\n", 1000)
+						osc.Spec.Files = append(osc.Spec.Files, inlineFileWithContent("/some/large-file", largeFileContent))
+					})
+
+					It("should not change the user data if it does not exceed the limit", func() {
+						userData, _, _, _, err := NewActuator(mgr, ActuatorOptions{}).Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						actuator = NewActuator(mgr, ActuatorOptions{UserDataSizeLimits: map[string]int{"aws": 64 * 1024}, CompressUserData: true})
+						limitedUserData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(limitedUserData).To(Equal(userData))
+					})
+
+					It("should not limit the user data of other provider types", func() {
+						actuator = NewActuator(mgr, ActuatorOptions{UserDataSizeLimits: map[string]int{"azure": 1024}})
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(len(userData)).To(BeNumerically(">", 16*1024))
+					})
+
+					It("should fail with a configuration error naming the largest contributors", func() {
+						actuator = NewActuator(mgr, ActuatorOptions{UserDataSizeLimits: map[string]int{"aws": 16 * 1024}})
+
+						_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).To(MatchError(And(
+							ContainSubstring(`exceeds the limit of 16384 bytes for provider type "aws"`),
+							ContainSubstring(`largest contributors: file "/some/large-file" (19000 bytes), file "/opt/gardener/bin/wait-for-network.sh" (`+strconv.Itoa(len(waitForNetworkScript))+` bytes), file "/etc/containerd/config.toml" (554 bytes), unit "containerd.service" (88 bytes), file "/etc/modules-load.d/gardener.conf" (5 bytes)`),
+							ContainSubstring("consider enabling the compression of the user data"),
+						)))
+						Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+					})
+
+					It("should compress a bash script into a self-extracting script", func() {
+						userData, _, _, _, err := NewActuator(mgr, ActuatorOptions{}).Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						actuator = NewActuator(mgr, ActuatorOptions{UserDataSizeLimits: map[string]int{"aws": 16 * 1024}, CompressUserData: true})
+						compressedUserData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(len(compressedUserData)).To(BeNumerically("<=", 16*1024))
+						Expect(string(compressedUserData)).To(HavePrefix("#!/bin/bash\n"))
+						Expect(extractSelfExtractingScript(string(compressedUserData))).To(Equal(string(userData)))
+					})
+
+					It("should compress a cloud-config document into a gzip part", func() {
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							UserDataFormat: ptr.To(gardenlinuxv1alpha1.UserDataFormatCloudConfig),
+						})).To(Succeed())
+						userData, _, _, _, err := NewActuator(mgr, ActuatorOptions{}).Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						actuator = NewActuator(mgr, ActuatorOptions{UserDataSizeLimits: map[string]int{"aws": 16 * 1024}, CompressUserData: true})
+						compressedUserData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(len(compressedUserData)).To(BeNumerically("<=", 16*1024))
+						parts := readMimeMultiParts(string(compressedUserData))
+						Expect(parts).To(HaveLen(1))
+						Expect(parts[0].contentType).To(Equal("application/x-gzip"))
+						Expect(gunzipBase64(parts[0].content)).To(Equal(string(userData)))
+					})
+
+					It("should compress the files of an Ignition config", func() {
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							UserDataFormat: ptr.To(gardenlinuxv1alpha1.UserDataFormatIgnition),
+						})).To(Succeed())
+
+						actuator = NewActuator(mgr, ActuatorOptions{UserDataSizeLimits: map[string]int{"aws": 16 * 1024}, CompressUserData: true})
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(len(userData)).To(BeNumerically("<=", 16*1024))
+						Expect(string(userData)).To(ContainSubstring(`"compression":"gzip"`))
+					})
+
+					It("should fail if the compressed user data still exceeds the limit", func() {
+						actuator = NewActuator(mgr, ActuatorOptions{UserDataSizeLimits: map[string]int{"aws": 1024}, CompressUserData: true})
+
+						_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).To(MatchError(ContainSubstring(`exceeds the limit of 1024 bytes for provider type "aws"`)))
+						Expect(err).NotTo(MatchError(ContainSubstring("consider enabling the compression")))
+					})
+				})
+
+				Context("User data formats", func() {
+					var expectedFiles map[string]string
+
+					BeforeEach(func() {
+						Expect(fakeClient.Create(ctx, &corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Name: "some-secret", Namespace: osc.Namespace},
+							Data:       map[string][]byte{"some-key": []byte("secret-data")},
+						})).To(Succeed())
+
+						osc.Spec.Files = append(osc.Spec.Files, extensionsv1alpha1.File{
+							Path:        "/some/secret-file",
+							Content:     extensionsv1alpha1.FileContent{SecretRef: &extensionsv1alpha1.FileContentSecretRef{Name: "some-secret", DataKey: "some-key"}},
+							Permissions: ptr.To(uint32(0600)),
+						})
+						osc.Spec.Units = append(osc.Spec.Units, extensionsv1alpha1.Unit{
+							Name:    "other-unit.service",
+							DropIns: []extensionsv1alpha1.DropIn{{Name: "10-override.conf", Content: "[Service]\nRestart=always"}},
+						})
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						expectedFiles = filesFromScript(string(userData))
+						Expect(expectedFiles).To(Equal(map[string]string{
+							"/etc/systemd/system/containerd.service.d/11-exec_config.conf": "[Service]\nExecStart=\nExecStart=/usr/bin/containerd --config=/etc/containerd/config.toml\n",
+							"/some/file":                                                "bar",
+							"/some/secret-file":                                         "secret-data",
+							"/etc/modules-load.d/gardener.conf":                         "nfsd\n",
+							"/etc/systemd/system/some-unit.service":                     "foo",
+							"/etc/containerd/config.toml":                               expectedContainerdConfig,
+							"/opt/gardener/bin/wait-for-network.sh":                     string(waitForNetworkScript),
+							"/etc/systemd/system/other-unit.service.d/10-override.conf": "[Service]\nRestart=always",
+						}))
+					})
+
+					It("should render a cloud-config document with the same files and commands", func() {
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							UserDataFormat: ptr.To(gardenlinuxv1alpha1.UserDataFormatCloudConfig),
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						files, commands := decodeCloudConfig(string(userData))
+						Expect(files).To(Equal(expectedFiles))
+						Expect(commands).To(Equal([]string{
+							provisionReportFunctions,
+							"provision_step_begin 'kernel-modules'",
+							"modprobe 'nfsd'",
+							"provision_step_end $?",
+							"provision_step_begin 'network'",
+							`'/opt/gardener/bin/wait-for-network.sh' '--timeout' '300' '--retry-interval' '5' '--network-online' || exit 1`,
+							"provision_step_end $?",
+							"provision_step_begin 'containerd'",
+							"systemctl daemon-reload",
+							"systemctl enable 'containerd.service'",
+							"systemctl restart 'containerd.service'",
+							"provision_step_end $?",
+							"provision_step_begin 'units'",
+							"systemctl enable 'some-unit.service'",
+							"systemctl restart --no-block 'some-unit.service'",
+							"systemctl enable 'other-unit.service'",
+							"systemctl restart --no-block 'other-unit.service'",
+							"provision_step_end $?",
+							"mkdir -p /var/lib/osc && touch /var/lib/osc/provision-osc-applied",
+						}))
+					})
+
+					It("should render a MIME multipart document with the same files and commands", func() {
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							UserDataFormat: ptr.To(gardenlinuxv1alpha1.UserDataFormatMIMEMultipart),
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						parts := readMimeMultiParts(string(userData))
+						Expect(parts).To(HaveLen(2))
+						Expect(parts[0].contentType).To(Equal("text/cloud-config"))
+						Expect(parts[1].contentType).To(Equal("text/x-shellscript"))
+
+						files, commands := decodeCloudConfig(parts[0].content)
+						Expect(files).To(Equal(expectedFiles))
+						Expect(commands).To(BeEmpty())
+						Expect(parts[1].content).To(Equal(`#!/bin/bash
+if [ -f "/var/lib/osc/provision-osc-applied" ]; then
+  echo "Provision OSC already applied, exiting..."
+  exit 0
+fi
+
+` + provisionReportFunctions + `
+provision_step_begin 'kernel-modules'
+modprobe 'nfsd'
+provision_step_end $?
+provision_step_begin 'network'
+'/opt/gardener/bin/wait-for-network.sh' '--timeout' '300' '--retry-interval' '5' '--network-online' || exit 1
+provision_step_end $?
+provision_step_begin 'containerd'
+systemctl daemon-reload
+systemctl enable 'containerd.service'
+systemctl restart 'containerd.service'
+provision_step_end $?
+provision_step_begin 'units'
+systemctl enable 'some-unit.service'
+systemctl restart --no-block 'some-unit.service'
+systemctl enable 'other-unit.service'
+systemctl restart --no-block 'other-unit.service'
+provision_step_end $?
+
+
+mkdir -p /var/lib/osc
+touch /var/lib/osc/provision-osc-applied
+`))
+					})
+
+					It("should render an Ignition config with the same files and units", func() {
+						osc.Spec.Units = append(osc.Spec.Units, extensionsv1alpha1.Unit{
+							Name:    "containerd.service",
+							DropIns: []extensionsv1alpha1.DropIn{{Name: "preconfig-script.conf", Content: "[Service]\nExecStartPre=/bin/true"}},
+						})
+						expectedFiles["/etc/systemd/system/containerd.service.d/preconfig-script.conf"] = "[Service]\nExecStartPre=/bin/true"
+
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							UserDataFormat: ptr.To(gardenlinuxv1alpha1.UserDataFormatIgnition),
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						files, modes, enabledUnits := decodeIgnition(string(userData))
+						script := files["/opt/gardener/bin/provision-osc.sh"]
+						delete(files, "/opt/gardener/bin/provision-osc.sh")
+						provisionUnit := files["/etc/systemd/system/gardener-provision-osc.service"]
+						delete(files, "/etc/systemd/system/gardener-provision-osc.service")
+
+						Expect(files).To(Equal(expectedFiles))
+						Expect(modes).To(Equal(map[string]int{
+							"/some/secret-file":                     0600,
+							"/etc/modules-load.d/gardener.conf":     0644,
+							"/etc/containerd/config.toml":           0644,
+							"/opt/gardener/bin/wait-for-network.sh": 0755,
+							"/opt/gardener/bin/provision-osc.sh":    0755,
+						}))
+						Expect(enabledUnits).To(ConsistOf("gardener-provision-osc.service"))
+						Expect(provisionUnit).To(ContainSubstring("ExecStart=/opt/gardener/bin/provision-osc.sh\n"))
+						Expect(script).To(HavePrefix("#!/bin/bash\n"))
+						Expect(script).To(ContainSubstring("\nsystemctl enable 'other-unit.service'\nsystemctl restart --no-block 'other-unit.service'\n"))
+						Expect(script).To(HaveSuffix("touch /var/lib/osc/provision-osc-applied\n"))
+					})
+
+					It("should use the default format of the extension if the provider config does not specify one", func() {
+						actuator = NewActuator(mgr, ActuatorOptions{UserDataFormat: "cloud-config"})
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+
+						files, _ := decodeCloudConfig(string(userData))
+						Expect(files).To(Equal(expectedFiles))
+					})
+
+					It("should prefer the format of the provider config over the default format of the extension", func() {
+						actuator = NewActuator(mgr, ActuatorOptions{UserDataFormat: "cloud-config"})
+						Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+							UserDataFormat: ptr.To(gardenlinuxv1alpha1.UserDataFormatBash),
+						})).To(Succeed())
+
+						userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(filesFromScript(string(userData))).To(Equal(expectedFiles))
+					})
+
+					It("should reject an unsupported format", func() {
+						osc.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","userDataFormat":"foo"}`)}
+
+						_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).To(MatchError(ContainSubstring("invalid provider config")))
+					})
+				})
 			})
 		})
-			When("OS type is 'memoryone-chost'", func() {
+		When("OS type is 'memoryone-chost'", func() {
 			var (
 				memoryOneConfiguration memoryonev1alpha1.OperatingSystemConfiguration
 			)
@@ -282,9 +1291,88 @@ touch /var/lib/osc/provision-osc-applied
 					Expect(extensionFiles).To(BeEmpty())
 					Expect(inplaceUpdateStatus).To(BeNil())
 				})
+
+				It("Should render the legacy values first and the other values sorted by their keys", func() {
+					memoryOneConfiguration.VsmpConfiguration = map[string]string{
+						"foo":           "bar",
+						"abc":           "xyz",
+						"system_memory": "13x",
+						"zzz":           "1",
+						"mem_topology":  "5",
+					}
+
+					Expect(encodeMemoryOneConfigurationIntoOsc(codec, osc, &memoryOneConfiguration)).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					parts := readMimeMultiParts(string(userData))
+					Expect(parts[0].content).To(Equal("mem_topology=2\nsystem_memory=6x\nabc=xyz\nfoo=bar\nzzz=1"))
+				})
+
+				It("Should render byte-identical user data for the same configuration", func() {
+					memoryOneConfiguration.VsmpConfiguration = map[string]string{}
+					for i := range 20 {
+						memoryOneConfiguration.VsmpConfiguration["key"+strconv.Itoa(i)] = strconv.Itoa(i)
+					}
+
+					Expect(encodeMemoryOneConfigurationIntoOsc(codec, osc, &memoryOneConfiguration)).To(Succeed())
+
+					userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					for range 100 {
+						rendered, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(rendered).To(Equal(userData))
+					}
+				})
 			})
-		})
 
+			It("should not support the Ignition format", func() {
+				actuator = NewActuator(mgr, ActuatorOptions{UserDataFormat: "ignition"})
+
+				_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+				Expect(err).To(MatchError(ContainSubstring(`user data format "ignition" is not supported`)))
+			})
+
+			It("should not compress the vSMP configuration", func() {
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&extensionsv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+					Spec: extensionsv1alpha1.ClusterSpec{
+						Shoot: runtime.RawExtension{Raw: []byte(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","spec":{"provider":{"type":"aws"}}}`)},
+					},
+				}).Build()
+				osc.Namespace = "shoot--foo--bar"
+				actuator = NewActuator(test.FakeManager{Client: fakeClient}, ActuatorOptions{UserDataSizeLimits: map[string]int{"aws": 6144}, CompressUserData: true})
+
+				userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+				Expect(err).NotTo(HaveOccurred())
+
+				vSmpConfig, compressedUserData := decodeVsmpUserData(string(userData))
+				Expect(vSmpConfig).To(BeEquivalentTo(map[string]string{
+					"mem_topology":  "2",
+					"system_memory": "6x",
+				}))
+				Expect(extractSelfExtractingScript(compressedUserData)).To(Equal(expectedUserData))
+			})
+
+			It("should add the vSMP configuration to the parts of the MIME multipart format", func() {
+				actuator = NewActuator(mgr, ActuatorOptions{UserDataFormat: "mime-multipart"})
+
+				userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+				Expect(err).NotTo(HaveOccurred())
+
+				parts := readMimeMultiParts(string(userData))
+				Expect(parts).To(HaveLen(3))
+				Expect(extractVsmpConfiguration(parts[0])).To(BeEquivalentTo(map[string]string{
+					"mem_topology":  "2",
+					"system_memory": "6x",
+				}))
+				Expect(parts[1].contentType).To(Equal("text/cloud-config"))
+				Expect(parts[2].contentType).To(Equal("text/x-shellscript"))
+			})
+		})
 
 	})
 
@@ -316,31 +1404,785 @@ touch /var/lib/osc/provision-osc-applied
 						},
 					}))
 				})
+
+				It("should write the update script to the configured path", func() {
+					actuator = NewActuator(mgr, ActuatorOptions{InPlaceUpdates: &config.InPlaceUpdates{ScriptPath: ptr.To("/usr/local/bin/update-os.sh")}})
+					osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{
+						OperatingSystemVersion: "1.0.0",
+					}
+
+					_, _, files, inplaceUpdateStatus, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(files).To(ContainElement(HaveField("Path", "/usr/local/bin/update-os.sh")))
+					Expect(inplaceUpdateStatus.OSUpdate.Command).To(Equal("/usr/local/bin/update-os.sh"))
+				})
 			})
 
-			It("should add one empty additional unit for containerd", func() {
-				_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+			It("should set the configured limits of containerd", func() {
+				actuator = NewActuator(mgr, ActuatorOptions{Containerd: &config.Containerd{LimitNOFILE: ptr.To[int64](65536)}})
+
+				_, units, _, _, err := actuator.Reconcile(ctx, log, osc)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(units).To(HaveLen(1))
-				Expect(units).To(ContainElement(
-					extensionsv1alpha1.Unit{
-						Name: "containerd.service",
-						DropIns: []extensionsv1alpha1.DropIn{
-							{
-								Name: "override.conf",
-								Content: `[Service]
+				Expect(units).To(ContainElement(HaveField("DropIns", ContainElement(extensionsv1alpha1.DropIn{
+					Name: "override.conf",
+					Content: `[Service]
 LimitMEMLOCK=67108864
-LimitNOFILE=1048576`,
+LimitNOFILE=65536`,
+				}))))
+			})
+
+			DescribeTable("should add the drop-ins of the containerd unit matching the major version of containerd",
+				func(majorVersion *int32, expectedLimits string) {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Containerd: &gardenlinuxv1alpha1.Containerd{MajorVersion: majorVersion},
+					})).To(Succeed())
+
+					_, units, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(units).To(ContainElement(
+						extensionsv1alpha1.Unit{
+							Name: "containerd.service",
+							DropIns: []extensionsv1alpha1.DropIn{
+								{
+									Name: "11-exec_config.conf",
+									Content: `[Service]
+ExecStart=
+ExecStart=/usr/bin/containerd --config=/etc/containerd/config.toml
+`,
+								},
+								{
+									Name:    "override.conf",
+									Content: expectedLimits,
+								},
 							},
 						},
+					))
+				},
+
+				Entry("default", nil, "[Service]\nLimitMEMLOCK=67108864\nLimitNOFILE=1048576"),
+				Entry("containerd 1.x", ptr.To[int32](1), "[Service]\nLimitMEMLOCK=67108864\nLimitNOFILE=1048576"),
+				Entry("containerd 2.x", ptr.To[int32](2), "[Service]\nLimitMEMLOCK=67108864\nLimitNOFILE=1024:1048576"),
+			)
+
+			It("should detect the major version of containerd from the machine image version of the worker pool", func() {
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&extensionsv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+					Spec: extensionsv1alpha1.ClusterSpec{
+						Shoot: runtime.RawExtension{Raw: []byte(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","spec":{"provider":{"type":"aws","workers":[` +
+							`{"name":"new","machine":{"type":"m5.large","image":{"name":"gardenlinux","version":"2000.0.0"}}}]}}}`)},
 					},
-				))
-				Expect(files).To(BeEmpty())
+				}).Build()
+				actuator = NewActuator(test.FakeManager{Client: fakeClient}, ActuatorOptions{ContainerdV2MinOSVersion: "2000.0"})
+				osc.Namespace = "shoot--foo--bar"
+				osc.Labels = map[string]string{"worker.gardener.cloud/pool": "new"}
+
+				_, units, _, _, err := actuator.Reconcile(ctx, log, osc)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(units).To(ContainElement(HaveField("DropIns", ContainElement(extensionsv1alpha1.DropIn{
+					Name:    "override.conf",
+					Content: "[Service]\nLimitMEMLOCK=67108864\nLimitNOFILE=1024:1048576",
+				}))))
+			})
+
+			Context("Sysctls", func() {
+				It("should not set any kernel parameters by default", func() {
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).NotTo(ContainElement(HaveField("Name", "systemd-sysctl.service")))
+					Expect(files).NotTo(ContainElement(HaveField("Path", "/etc/sysctl.d/99-gardener.conf")))
+				})
+
+				It("should merge profiles and parameters in a stable order", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Sysctls: &gardenlinuxv1alpha1.Sysctls{
+							Profiles: []gardenlinuxv1alpha1.SysctlProfile{"elasticsearch"},
+							Parameters: map[string]string{
+								"vm.swappiness":      "10",
+								"net.core.somaxconn": "1024",
+							},
+						},
+					})).To(Succeed())
+
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+						Name:      "systemd-sysctl.service",
+						FilePaths: []string{"/etc/sysctl.d/99-gardener.conf"},
+					}))
+					Expect(files).To(ContainElement(inlineFileWithContent("/etc/sysctl.d/99-gardener.conf", `net.core.somaxconn = 1024
+vm.max_map_count = 262144
+vm.swappiness = 10
+`)))
+				})
+
+				It("should set the default kernel parameters of the extension with the lowest precedence", func() {
+					actuator = NewActuator(mgr, ActuatorOptions{NodeTuning: &config.NodeTuning{Sysctls: map[string]string{
+						"vm.max_map_count": "65530",
+						"kernel.pid_max":   "4194304",
+					}}})
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Sysctls: &gardenlinuxv1alpha1.Sysctls{
+							Profiles: []gardenlinuxv1alpha1.SysctlProfile{"elasticsearch"},
+						},
+					})).To(Succeed())
+
+					_, _, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(files).To(ContainElement(inlineFileWithContent("/etc/sysctl.d/99-gardener.conf", `kernel.pid_max = 4194304
+vm.max_map_count = 262144
+vm.swappiness = 1
+`)))
+				})
+			})
+
+			Context("Network", func() {
+				It("should not configure systemd-networkd by default", func() {
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).NotTo(ContainElement(HaveField("Name", "systemd-networkd.service")))
+					Expect(files).NotTo(ContainElement(HaveField("Path", HavePrefix("/etc/systemd/network/"))))
+				})
+
+				It("should write the network configurations and restart systemd-networkd when they change", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Network: &gardenlinuxv1alpha1.Network{
+							Interfaces: []gardenlinuxv1alpha1.NetworkInterface{
+								{
+									Name:  "primary",
+									Match: gardenlinuxv1alpha1.NetworkMatch{Names: []string{"eth0", "en*"}},
+									MTU:   ptr.To[int32](8900),
+									DHCP: &gardenlinuxv1alpha1.DHCP{
+										UseDNS:      ptr.To(false),
+										RouteMetric: ptr.To[int32](100),
+									},
+									Routes: []gardenlinuxv1alpha1.Route{
+										{Destination: "10.10.0.0/16", Gateway: ptr.To("10.0.0.1"), Metric: ptr.To[int32](50)},
+										{Destination: "169.254.169.254/32"},
+									},
+								},
+								{
+									Name:      "storage",
+									Match:     gardenlinuxv1alpha1.NetworkMatch{MACAddresses: []string{"02:00:00:00:00:01"}, Drivers: []string{"mlx5_core"}},
+									DHCP:      &gardenlinuxv1alpha1.DHCP{Mode: ptr.To(gardenlinuxv1alpha1.DHCPModeNo)},
+									Addresses: []string{"192.168.100.10/24"},
+								},
+							},
+						},
+					})).To(Succeed())
+
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+						Name:      "systemd-networkd.service",
+						FilePaths: []string{"/etc/systemd/network/10-gardener-primary.network", "/etc/systemd/network/10-gardener-storage.network"},
+					}))
+					Expect(files).To(ContainElements(
+						inlineFileWithContent("/etc/systemd/network/10-gardener-primary.network", `[Match]
+Name=eth0 en*
+
+[Link]
+MTUBytes=8900
+
+[Network]
+DHCP=yes
+
+[DHCPv4]
+UseDNS=false
+RouteMetric=100
+
+[DHCPv6]
+UseDNS=false
+
+[Route]
+Destination=10.10.0.0/16
+Gateway=10.0.0.1
+Metric=50
+
+[Route]
+Destination=169.254.169.254/32
+`),
+						inlineFileWithContent("/etc/systemd/network/10-gardener-storage.network", `[Match]
+MACAddress=02:00:00:00:00:01
+Driver=mlx5_core
+
+[Network]
+DHCP=no
+Address=192.168.100.10/24
+`),
+					))
+				})
+			})
+
+			Context("Swap", func() {
+				It("should not set up swap by default", func() {
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).NotTo(ContainElement(HaveField("Name", "gardener-swap.service")))
+					Expect(files).NotTo(ContainElement(HaveField("Path", "/opt/gardener/bin/setup-swap.sh")))
+				})
+
+				It("should set up a zram device", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Swap: &gardenlinuxv1alpha1.Swap{Zram: &gardenlinuxv1alpha1.Zram{Size: resource.MustParse("2Gi")}},
+					})).To(Succeed())
+
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+						Name:    "gardener-swap.service",
+						Enable:  ptr.To(true),
+						Command: ptr.To(extensionsv1alpha1.CommandRestart),
+						Content: ptr.To(`[Unit]
+Description=Set up swap space for the kubelet
+DefaultDependencies=no
+After=local-fs.target systemd-modules-load.service
+Before=swap.target kubelet.service
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/opt/gardener/bin/setup-swap.sh zram 2147483648 zstd
+ExecStop=/usr/sbin/swapoff /dev/zram0
+[Install]
+WantedBy=swap.target multi-user.target
+`),
+						FilePaths: []string{"/opt/gardener/bin/setup-swap.sh"},
+					}))
+					Expect(files).To(ContainElement(HaveField("Path", "/opt/gardener/bin/setup-swap.sh")))
+				})
+
+				It("should set up a swap file with the size rounded up to full mebibytes", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						Swap: &gardenlinuxv1alpha1.Swap{File: &gardenlinuxv1alpha1.SwapFile{Size: resource.MustParse("1G"), Path: ptr.To("/var/swap.img")}},
+					})).To(Succeed())
+
+					_, units, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElement(HaveField("Content", PointTo(And(
+						ContainSubstring("\nExecStart=/opt/gardener/bin/setup-swap.sh file /var/swap.img 1000341504\n"),
+						ContainSubstring("\nExecStop=/usr/sbin/swapoff /var/swap.img\n"),
+					)))))
+				})
+			})
+
+			Context("NTP", func() {
+				It("should not configure the time synchronisation by default", func() {
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).NotTo(ContainElement(HaveField("Name", Or(Equal("systemd-timesyncd.service"), Equal("chrony.service")))))
+					Expect(files).NotTo(ContainElement(HaveField("Path", Or(Equal("/etc/systemd/timesyncd.conf.d/gardener.conf"), Equal("/etc/chrony/gardener.conf")))))
+				})
+
+				It("should configure systemd-timesyncd by default", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						NTP: &gardenlinuxv1alpha1.NTP{Servers: []string{"ntp1.example.com", "10.0.0.123"}},
+					})).To(Succeed())
+
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+						Name:      "systemd-timesyncd.service",
+						Enable:    ptr.To(true),
+						Command:   ptr.To(extensionsv1alpha1.CommandRestart),
+						FilePaths: []string{"/etc/systemd/timesyncd.conf.d/gardener.conf"},
+					}))
+					Expect(units).NotTo(ContainElement(HaveField("Name", "chrony.service")))
+					Expect(files).To(ContainElement(inlineFileWithContent("/etc/systemd/timesyncd.conf.d/gardener.conf", "[Time]\nNTP=ntp1.example.com 10.0.0.123\n")))
+				})
+
+				It("should configure chrony and disable systemd-timesyncd", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						NTP: &gardenlinuxv1alpha1.NTP{
+							Daemon:  ptr.To(gardenlinuxv1alpha1.NTPDaemonChrony),
+							Servers: []string{"ntp1.example.com", "fd00::123"},
+						},
+					})).To(Succeed())
+
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElements(
+						extensionsv1alpha1.Unit{
+							Name:    "chrony.service",
+							Enable:  ptr.To(true),
+							Command: ptr.To(extensionsv1alpha1.CommandRestart),
+							DropIns: []extensionsv1alpha1.DropIn{{
+								Name:    "10-gardener-config.conf",
+								Content: "[Service]\nExecStart=\nExecStart=/usr/sbin/chronyd -f /etc/chrony/gardener.conf\n",
+							}},
+							FilePaths: []string{"/etc/chrony/gardener.conf"},
+						},
+						extensionsv1alpha1.Unit{
+							Name:   "systemd-timesyncd.service",
+							Enable: ptr.To(false),
+						},
+					))
+					Expect(files).To(ContainElement(inlineFileWithContent("/etc/chrony/gardener.conf", `server ntp1.example.com iburst
+server fd00::123 iburst
+driftfile /var/lib/chrony/chrony.drift
+makestep 1.0 3
+rtcsync
+`)))
+				})
+			})
+
+			Context("CA bundles", func() {
+				It("should not update the trust store by default", func() {
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).NotTo(ContainElement(HaveField("Name", "gardener-ca-certificates.service")))
+					Expect(files).NotTo(ContainElement(HaveField("Path", "/opt/gardener/bin/update-ca-certificates.sh")))
+				})
+
+				It("should add the inline and referenced bundles to the trust store", func() {
+					Expect(fakeClient.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "ca-secret", Namespace: osc.Namespace},
+						Data:       map[string][]byte{"bundle.pem": []byte(caBundle)},
+					})).To(Succeed())
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						CABundles: []gardenlinuxv1alpha1.CABundle{
+							{Name: "corporate", Inline: ptr.To(caBundle)},
+							{Name: "registry", SecretRef: &gardenlinuxv1alpha1.SecretKeyReference{Name: "ca-secret", DataKey: "bundle.pem"}},
+						},
+					})).To(Succeed())
+
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+						Name:    "gardener-ca-certificates.service",
+						Enable:  ptr.To(true),
+						Command: ptr.To(extensionsv1alpha1.CommandRestart),
+						Content: ptr.To(`[Unit]
+Description=Add the CA bundles of Gardener to the trust store
+Before=containerd.service
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/opt/gardener/bin/update-ca-certificates.sh
+[Install]
+WantedBy=multi-user.target
+`),
+						FilePaths: []string{
+							"/opt/gardener/bin/update-ca-certificates.sh",
+							"/usr/local/share/ca-certificates/gardener-corporate.crt",
+							"/usr/local/share/ca-certificates/gardener-registry.crt",
+						},
+					}))
+					Expect(files).To(ContainElements(
+						inlineFileWithContent("/usr/local/share/ca-certificates/gardener-corporate.crt", caBundle),
+						inlineFileWithContent("/usr/local/share/ca-certificates/gardener-registry.crt", caBundle),
+						HaveField("Path", "/opt/gardener/bin/update-ca-certificates.sh"),
+					))
+				})
+
+				It("should fail if the referenced secret does not exist", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						CABundles: []gardenlinuxv1alpha1.CABundle{{Name: "registry", SecretRef: &gardenlinuxv1alpha1.SecretKeyReference{Name: "ca-secret", DataKey: "bundle.pem"}}},
+					})).To(Succeed())
+
+					_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).To(MatchError(ContainSubstring(`failed to read CA bundle "registry"`)))
+				})
+
+				It("should fail with a configuration error if the referenced secret does not contain certificates", func() {
+					Expect(fakeClient.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "ca-secret", Namespace: osc.Namespace},
+						Data:       map[string][]byte{"bundle.pem": []byte("foo")},
+					})).To(Succeed())
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						CABundles: []gardenlinuxv1alpha1.CABundle{{Name: "registry", SecretRef: &gardenlinuxv1alpha1.SecretKeyReference{Name: "ca-secret", DataKey: "bundle.pem"}}},
+					})).To(Succeed())
+
+					_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).To(MatchError(ContainSubstring(`invalid CA bundle "registry" in key "bundle.pem" of secret /ca-secret`)))
+					Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+				})
+			})
+
+			Context("Local storage", func() {
+				It("should not set up local storage by default", func() {
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).NotTo(ContainElement(HaveField("Name", "gardener-local-storage.service")))
+					Expect(files).NotTo(ContainElement(HaveField("Path", "/opt/gardener/bin/setup-local-storage.sh")))
+				})
+
+				It("should return the setup unit and the mount units", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						LocalStorage: &gardenlinuxv1alpha1.LocalStorage{
+							Disks: gardenlinuxv1alpha1.DiskSelector{
+								Models:  []string{"Amazon EC2 NVMe Instance Storage"},
+								MinSize: ptr.To(resource.MustParse("100Gi")),
+							},
+							Filesystem:  ptr.To(gardenlinuxv1alpha1.FilesystemXFS),
+							MountPoint:  ptr.To("/var/mnt/data"),
+							Directories: []string{"/var/lib/containerd"},
+						},
+					})).To(Succeed())
+
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElements(
+						extensionsv1alpha1.Unit{
+							Name: "gardener-local-storage.service",
+							Content: ptr.To(`[Unit]
+Description=Set up the local storage of the node
+DefaultDependencies=no
+After=local-fs-pre.target systemd-udevd.service
+Before=var-mnt-data.mount
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/opt/gardener/bin/setup-local-storage.sh /etc/gardener/local-storage.conf
+[Install]
+WantedBy=local-fs.target
+`),
+							FilePaths: []string{"/opt/gardener/bin/setup-local-storage.sh", "/etc/gardener/local-storage.conf"},
+						},
+						extensionsv1alpha1.Unit{
+							Name: "var-mnt-data.mount",
+							Content: ptr.To(`[Unit]
+Description=Local storage of the node
+Wants=gardener-local-storage.service
+After=gardener-local-storage.service
+[Mount]
+What=/dev/disk/by-label/gardener-md
+Where=/var/mnt/data
+Type=xfs
+Options=noatime
+[Install]
+WantedBy=local-fs.target
+`),
+						},
+						extensionsv1alpha1.Unit{
+							Name: "var-lib-containerd.mount",
+							Content: ptr.To(`[Unit]
+Description=Bind mount of /var/lib/containerd from the local storage of the node
+RequiresMountsFor=/var/mnt/data
+Before=containerd.service kubelet.service
+[Mount]
+What=/var/mnt/data/var-lib-containerd
+Where=/var/lib/containerd
+Type=none
+Options=bind
+[Install]
+WantedBy=local-fs.target
+`),
+						},
+					))
+					Expect(units).NotTo(ContainElement(HaveField("Name", "var-lib-kubelet.mount")))
+					Expect(files).To(ContainElement(inlineFileWithContent("/etc/gardener/local-storage.conf", `MOUNT_POINT='/var/mnt/data'
+FILESYSTEM='xfs'
+MIN_SIZE=107374182400
+MAX_SIZE=
+MODELS=('Amazon EC2 NVMe Instance Storage')
+PATHS=()
+DIRECTORIES=('var-lib-containerd')
+`)))
+				})
+			})
+
+			Context("Kernel Modules", func() {
+				It("should load the nfsd kernel module by default", func() {
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+						Name:      "systemd-modules-load.service",
+						FilePaths: []string{"/etc/modules-load.d/gardener.conf"},
+					}))
+					Expect(files).To(ConsistOf(inlineFileWithContent("/etc/modules-load.d/gardener.conf", "nfsd\n")))
+				})
+
+				It("should load and blacklist the configured kernel modules", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						KernelModules: &gardenlinuxv1alpha1.KernelModules{
+							Load:      []string{"br_netfilter", "ip_vs"},
+							Blacklist: []string{"nfsd"},
+						},
+					})).To(Succeed())
+
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+						Name:      "systemd-modules-load.service",
+						FilePaths: []string{"/etc/modules-load.d/gardener.conf"},
+					}))
+					Expect(files).To(ConsistOf(
+						inlineFileWithContent("/etc/modules-load.d/gardener.conf", "br_netfilter\nip_vs\n"),
+						inlineFileWithContent("/etc/modprobe.d/gardener-blacklist.conf", "blacklist nfsd\n"),
+					))
+				})
+
+				It("should not load any kernel module if none are configured", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						KernelModules: &gardenlinuxv1alpha1.KernelModules{},
+					})).To(Succeed())
+
+					_, units, files, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(units).NotTo(ContainElement(HaveField("Name", "systemd-modules-load.service")))
+					Expect(files).To(BeEmpty())
+				})
+
+				It("should reject invalid kernel module names", func() {
+					Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+						KernelModules: &gardenlinuxv1alpha1.KernelModules{
+							Load: []string{"nfsd; reboot"},
+						},
+					})).To(Succeed())
+
+					_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+					Expect(err).To(MatchError(ContainSubstring("invalid provider config")))
+				})
 			})
 		})
 	})
+
+	Describe("Provider status", func() {
+		providerStatus := func() *gardenlinuxv1alpha1.OperatingSystemConfigurationStatus {
+			GinkgoHelper()
+
+			current := &extensionsv1alpha1.OperatingSystemConfig{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(osc), current)).To(Succeed())
+			Expect(current.Status.ProviderStatus).NotTo(BeNil())
+
+			status := &gardenlinuxv1alpha1.OperatingSystemConfigurationStatus{}
+			Expect(json.Unmarshal(current.Status.ProviderStatus.Raw, status)).To(Succeed())
+			return status
+		}
+
+		BeforeEach(func() {
+			osc.Namespace = "shoot--foo--bar"
+			osc.Name = "pool-01-original"
+			Expect(fakeClient.Create(ctx, osc)).To(Succeed())
+		})
+
+		It("should publish the rendered user data", func() {
+			userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(providerStatus()).To(Equal(&gardenlinuxv1alpha1.OperatingSystemConfigurationStatus{
+				TypeMeta: metav1.TypeMeta{APIVersion: "gardenlinux.os.extensions.gardener.cloud/v1alpha1", Kind: "OperatingSystemConfigurationStatus"},
+				UserData: &gardenlinuxv1alpha1.UserDataStatus{
+					Format: gardenlinuxv1alpha1.UserDataFormatBash,
+					Hash:   utils.ComputeSHA256Hex(userData),
+					Size:   len(userData),
+				},
+			}))
+		})
+
+		It("should publish the effective MemoryOne parameters", func() {
+			osc.Spec.Type = memoryone.OSTypeMemoryOneGardenLinux
+			Expect(encodeMemoryOneConfigurationIntoOsc(codec, osc, &memoryonev1alpha1.OperatingSystemConfiguration{
+				SystemMemory:      ptr.To("7x"),
+				VsmpConfiguration: map[string]string{"foo": "bar;baz"},
+			})).To(Succeed())
+
+			userData, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			status := providerStatus()
+			Expect(status.UserData).To(Equal(&gardenlinuxv1alpha1.UserDataStatus{
+				Format: gardenlinuxv1alpha1.UserDataFormatBash,
+				Hash:   utils.ComputeSHA256Hex(userData),
+				Size:   len(userData),
+			}))
+			Expect(status.MemoryOne).To(Equal(&gardenlinuxv1alpha1.MemoryOneStatus{
+				VsmpConfiguration: map[string]string{
+					"mem_topology":  "2",
+					"system_memory": "7x",
+					"foo":           "bar",
+				},
+				Warnings: []string{"spec.providerConfig.vsmpConfiguration[foo]: unknown vSMP parameter is passed through without validation"},
+			}))
+		})
+
+		It("should publish warnings about the vSMP parameters which are passed through or overridden", func() {
+			osc.Spec.Type = memoryone.OSTypeMemoryOneGardenLinux
+			Expect(encodeMemoryOneConfigurationIntoOsc(codec, osc, &memoryonev1alpha1.OperatingSystemConfiguration{
+				MemoryTopology:    ptr.To("3"),
+				VsmpConfiguration: map[string]string{"mem_topology": "4", "zzz": "1", "aaa": "1"},
+			})).To(Succeed())
+
+			_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(providerStatus().MemoryOne.Warnings).To(Equal([]string{
+				"spec.providerConfig.vsmpConfiguration[aaa]: unknown vSMP parameter is passed through without validation",
+				"spec.providerConfig.vsmpConfiguration[mem_topology]: vSMP parameter is overridden by spec.providerConfig.memoryTopology",
+				"spec.providerConfig.vsmpConfiguration[zzz]: unknown vSMP parameter is passed through without validation",
+			}))
+		})
+
+		It("should not publish warnings for a MemoryOne configuration with known parameters only", func() {
+			osc.Spec.Type = memoryone.OSTypeMemoryOneGardenLinux
+			Expect(encodeMemoryOneConfigurationIntoOsc(codec, osc, &memoryonev1alpha1.OperatingSystemConfiguration{
+				SystemMemory: ptr.To("7x"),
+			})).To(Succeed())
+
+			_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(providerStatus().MemoryOne.Warnings).To(BeEmpty())
+		})
+
+		It("should publish the in-place update script", func() {
+			osc.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeReconcile
+			osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{OperatingSystemVersion: "1.0.0"}
+
+			_, _, files, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			script := files[slices.IndexFunc(files, func(file extensionsv1alpha1.File) bool { return file.Path == "/opt/gardener/bin/inplace-update.sh" })]
+			content, err := utils.DecodeBase64(script.Content.Inline.Data)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(providerStatus()).To(Equal(&gardenlinuxv1alpha1.OperatingSystemConfigurationStatus{
+				TypeMeta: metav1.TypeMeta{APIVersion: "gardenlinux.os.extensions.gardener.cloud/v1alpha1", Kind: "OperatingSystemConfigurationStatus"},
+				InPlaceUpdates: &gardenlinuxv1alpha1.InPlaceUpdatesStatus{
+					ScriptPath:    "/opt/gardener/bin/inplace-update.sh",
+					ScriptVersion: utils.ComputeSHA256Hex(content),
+				},
+			}))
+		})
+
+		It("should remove the in-place update script once no in-place update is requested anymore", func() {
+			osc.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeReconcile
+			osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{OperatingSystemVersion: "1.0.0"}
+			_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			osc.Spec.InPlaceUpdates = nil
+			_, _, _, _, err = actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(providerStatus().InPlaceUpdates).To(BeNil())
+		})
+	})
+
+	Describe("Metrics", func() {
+		BeforeEach(func() {
+			osc.Namespace = "shoot--foo--bar"
+			osc.Name = "pool-01-original"
+		})
+
+		It("should record the reconciliations, the user data size and the number of files and units", func() {
+			reconciles := metricValue("os_gardenlinux_operatingsystemconfig_reconciles_total", map[string]string{"os_type": "gardenlinux", "purpose": "provision", "result": "success"})
+			renders := metricValue("os_gardenlinux_operatingsystemconfig_render_duration_seconds", map[string]string{"os_type": "gardenlinux", "purpose": "provision"})
+			userDataSizes := metricValue("os_gardenlinux_operatingsystemconfig_user_data_size_bytes", map[string]string{"os_type": "gardenlinux", "format": "bash"})
+
+			_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_reconciles_total", map[string]string{"os_type": "gardenlinux", "purpose": "provision", "result": "success"})).To(Equal(reconciles + 1))
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_render_duration_seconds", map[string]string{"os_type": "gardenlinux", "purpose": "provision"})).To(Equal(renders + 1))
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_user_data_size_bytes", map[string]string{"os_type": "gardenlinux", "format": "bash"})).To(Equal(userDataSizes + 1))
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_files", map[string]string{"namespace": osc.Namespace, "name": osc.Name, "purpose": "provision"})).To(Equal(float64(6)))
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_units", map[string]string{"namespace": osc.Namespace, "name": osc.Name, "purpose": "provision"})).To(Equal(float64(2)))
+
+			Expect(actuator.Delete(ctx, log, osc)).To(Succeed())
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_files", map[string]string{"namespace": osc.Namespace, "name": osc.Name, "purpose": "provision"})).To(BeZero())
+		})
+
+		It("should record failures to decode and validate the provider config", func() {
+			decodeFailures := metricValue("os_gardenlinux_operatingsystemconfig_provider_config_failures_total", map[string]string{"os_type": "gardenlinux", "reason": "decode"})
+			validationFailures := metricValue("os_gardenlinux_operatingsystemconfig_provider_config_failures_total", map[string]string{"os_type": "gardenlinux", "reason": "validation"})
+			failedReconciles := metricValue("os_gardenlinux_operatingsystemconfig_reconciles_total", map[string]string{"os_type": "gardenlinux", "purpose": "provision", "result": "error"})
+
+			osc.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","kernelModules":"nfsd"}`)}
+			_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).To(HaveOccurred())
+
+			Expect(encodeGardenLinuxConfigurationIntoOsc(gardenLinuxCodec, osc, &gardenlinuxv1alpha1.OperatingSystemConfiguration{
+				KernelModules: &gardenlinuxv1alpha1.KernelModules{Load: []string{"nfsd; reboot"}},
+			})).To(Succeed())
+			_, _, _, _, err = actuator.Reconcile(ctx, log, osc)
+			Expect(err).To(HaveOccurred())
+
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_provider_config_failures_total", map[string]string{"os_type": "gardenlinux", "reason": "decode"})).To(Equal(decodeFailures + 1))
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_provider_config_failures_total", map[string]string{"os_type": "gardenlinux", "reason": "validation"})).To(Equal(validationFailures + 1))
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_reconciles_total", map[string]string{"os_type": "gardenlinux", "purpose": "provision", "result": "error"})).To(Equal(failedReconciles + 2))
+		})
+
+		It("should record the MemoryOne configuration", func() {
+			osc.Spec.Type = memoryone.OSTypeMemoryOneGardenLinux
+			Expect(encodeMemoryOneConfigurationIntoOsc(codec, osc, &memoryonev1alpha1.OperatingSystemConfiguration{
+				MemoryTopology: ptr.To("3"),
+			})).To(Succeed())
+
+			_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_memoryone_configuration_info", map[string]string{"namespace": osc.Namespace, "name": osc.Name, "memory_topology": "3", "system_memory": "6x"})).To(Equal(float64(1)))
+		})
+
+		It("should record the target version of in-place updates", func() {
+			osc.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeReconcile
+			osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{OperatingSystemVersion: "1.0.0"}
+
+			_, _, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_inplace_update_target_version_info", map[string]string{"namespace": osc.Namespace, "name": osc.Name, "version": "1.0.0"})).To(Equal(float64(1)))
+
+			osc.Spec.InPlaceUpdates = nil
+			_, _, _, _, err = actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metricValue("os_gardenlinux_operatingsystemconfig_inplace_update_target_version_info", map[string]string{"namespace": osc.Namespace, "name": osc.Name})).To(BeZero())
+		})
+	})
 })
 
+// metricValue returns the sum of the values of the metrics with the given name whose labels match the given labels.
+// The value of a histogram is its number of observations.
+func metricValue(name string, labels map[string]string) float64 {
+	GinkgoHelper()
+
+	families, err := metrics.Registry.Gather()
+	Expect(err).NotTo(HaveOccurred())
+
+	var value float64
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+	metrics:
+		for _, metric := range family.GetMetric() {
+			for key, val := range labels {
+				if !slices.ContainsFunc(metric.GetLabel(), func(pair *dto.LabelPair) bool { return pair.GetName() == key && pair.GetValue() == val }) {
+					continue metrics
+				}
+			}
+
+			switch {
+			case metric.Counter != nil:
+				value += metric.GetCounter().GetValue()
+			case metric.Gauge != nil:
+				value += metric.GetGauge().GetValue()
+			case metric.Histogram != nil:
+				value += float64(metric.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	return value
+}
 
 type multiPart struct {
 	contentType string
@@ -418,6 +2260,142 @@ MIME-Version: 1.0`
 	return vsmpConfig, userData
 }
 
+var writeFileRegexp = regexp.MustCompile(`(?s)base64 -d > '([^'\n]+)' << 'EOF'\n(.*?)\nEOF`)
+
+// filesFromScript returns the files which the given provisioning script writes to the disk.
+func filesFromScript(script string) map[string]string {
+	GinkgoHelper()
+
+	files := make(map[string]string)
+	for _, match := range writeFileRegexp.FindAllStringSubmatch(script, -1) {
+		content, err := utils.DecodeBase64(match[2])
+		Expect(err).NotTo(HaveOccurred())
+		files[match[1]] = string(content)
+	}
+	return files
+}
+
+// decodeCloudConfig returns the files which the given cloud-config document writes to the disk and its commands.
+func decodeCloudConfig(s string) (map[string]string, []string) {
+	GinkgoHelper()
+	Expect(s).To(HavePrefix("#cloud-config\n"))
+
+	var config struct {
+		WriteFiles []struct {
+			Path     string `json:"path"`
+			Content  string `json:"content"`
+			Encoding string `json:"encoding"`
+		} `json:"write_files"`
+		RunCmd []string `json:"runcmd"`
+	}
+	Expect(yaml.Unmarshal([]byte(s), &config)).To(Succeed())
+
+	files := make(map[string]string, len(config.WriteFiles))
+	for _, file := range config.WriteFiles {
+		content := []byte(file.Content)
+		if file.Encoding == "b64" {
+			var err error
+			content, err = utils.DecodeBase64(file.Content)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		files[file.Path] = string(content)
+	}
+	return files, config.RunCmd
+}
+
+// decodeIgnition returns the files and units which the given Ignition config writes to the disk, the modes of the
+// files and the names of the enabled units.
+func decodeIgnition(s string) (map[string]string, map[string]int, []string) {
+	GinkgoHelper()
+
+	var config struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+		Storage struct {
+			Files []struct {
+				Path     string `json:"path"`
+				Contents struct {
+					Source string `json:"source"`
+				} `json:"contents"`
+				Mode *int `json:"mode"`
+			} `json:"files"`
+		} `json:"storage"`
+		Systemd struct {
+			Units []struct {
+				Name     string  `json:"name"`
+				Enabled  *bool   `json:"enabled"`
+				Contents *string `json:"contents"`
+				Dropins  []struct {
+					Name     string `json:"name"`
+					Contents string `json:"contents"`
+				} `json:"dropins"`
+			} `json:"units"`
+		} `json:"systemd"`
+	}
+	Expect(json.Unmarshal([]byte(s), &config)).To(Succeed())
+	Expect(config.Ignition.Version).To(HavePrefix("3."))
+
+	var (
+		files        = make(map[string]string)
+		modes        = make(map[string]int)
+		enabledUnits []string
+		unitNames    = make(map[string]struct{})
+	)
+
+	for _, file := range config.Storage.Files {
+		data, found := strings.CutPrefix(file.Contents.Source, "data:;base64,")
+		Expect(found).To(BeTrue())
+		content, err := utils.DecodeBase64(data)
+		Expect(err).NotTo(HaveOccurred())
+		files[file.Path] = string(content)
+		if file.Mode != nil {
+			modes[file.Path] = *file.Mode
+		}
+	}
+
+	for _, unit := range config.Systemd.Units {
+		Expect(unitNames).NotTo(HaveKey(unit.Name), "duplicate unit "+unit.Name)
+		unitNames[unit.Name] = struct{}{}
+
+		if unit.Contents != nil {
+			files["/etc/systemd/system/"+unit.Name] = *unit.Contents
+		}
+		for _, dropIn := range unit.Dropins {
+			files["/etc/systemd/system/"+unit.Name+".d/"+dropIn.Name] = dropIn.Contents
+		}
+		if ptr.Deref(unit.Enabled, false) {
+			enabledUnits = append(enabledUnits, unit.Name)
+		}
+	}
+
+	return files, modes, enabledUnits
+}
+
+var selfExtractingScriptRegexp = regexp.MustCompile(`(?s)base64 -d << 'EOF' \| gzip -d > "\$script"\n(.*?)\nEOF`)
+
+// extractSelfExtractingScript returns the script which the given self-extracting script extracts.
+func extractSelfExtractingScript(script string) string {
+	GinkgoHelper()
+
+	match := selfExtractingScriptRegexp.FindStringSubmatch(script)
+	Expect(match).To(HaveLen(2))
+	return gunzipBase64(match[1])
+}
+
+// gunzipBase64 returns the decompressed content of the given base64 encoded gzip data.
+func gunzipBase64(s string) string {
+	GinkgoHelper()
+
+	data, err := utils.DecodeBase64(s)
+	Expect(err).NotTo(HaveOccurred())
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	Expect(err).NotTo(HaveOccurred())
+	content, err := io.ReadAll(r)
+	Expect(err).NotTo(HaveOccurred())
+	return string(content)
+}
+
 func encodeMemoryOneConfigurationIntoOsc(codec runtime.Codec, osc *extensionsv1alpha1.OperatingSystemConfig, moc *memoryonev1alpha1.OperatingSystemConfiguration) error {
 	encoded, err := runtime.Encode(codec, moc)
 	if err != nil {
@@ -429,3 +2407,48 @@ func encodeMemoryOneConfigurationIntoOsc(codec runtime.Codec, osc *extensionsv1a
 	return nil
 }
 
+func encodeGardenLinuxConfigurationIntoOsc(codec runtime.Codec, osc *extensionsv1alpha1.OperatingSystemConfig, config *gardenlinuxv1alpha1.OperatingSystemConfiguration) error {
+	encoded, err := runtime.Encode(codec, config)
+	if err != nil {
+		return err
+	}
+	osc.Spec.ProviderConfig = &runtime.RawExtension{
+		Raw: encoded,
+	}
+	return nil
+}
+
+func inlineFileWithContent(path, content string) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        path,
+		Permissions: ptr.To(uint32(0644)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: "b64",
+				Data:     utils.EncodeBase64([]byte(content)),
+			},
+		},
+	}
+}
+
+func generateCACertificate() (string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
@@ -8,9 +8,12 @@ import (
 	"context"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/memoryone"
 )
@@ -24,13 +27,44 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// Actuator are the ActuatorOptions.
+	Actuator ActuatorOptions
+}
+
+// ActuatorOptions are options for the actuator of the OSC controller.
+type ActuatorOptions struct {
+	// UserDataFormat is the format of the user data which provisions the nodes if the provider config of the
+	// OperatingSystemConfig does not specify one. Defaults to `bash`.
+	UserDataFormat apisgardenlinux.UserDataFormat
+	// UserDataSizeLimits are the maximum sizes in bytes of the user data per provider type of the shoot.
+	UserDataSizeLimits map[string]int
+	// CompressUserData specifies whether user data which exceeds its size limit is compressed.
+	CompressUserData bool
+	// ContainerdV2MinOSVersion is the minimum machine image version which ships containerd 2.x. It is used to detect
+	// the major version of containerd of worker pools whose provider config does not specify it. If empty, containerd
+	// 1.x is assumed.
+	ContainerdV2MinOSVersion string
+	// NodeTuning contains the defaults for the tuning of the nodes. Unset fields default to the defaults of the
+	// ControllerConfiguration.
+	NodeTuning *config.NodeTuning
+	// Containerd contains the settings of containerd on all nodes. Unset fields default to the defaults of the
+	// ControllerConfiguration.
+	Containerd *config.Containerd
+	// InPlaceUpdates contains the settings of in-place updates. Unset fields default to the defaults of the
+	// ControllerConfiguration.
+	InPlaceUpdates *config.InPlaceUpdates
+	// ProvisionHooks are scripts which run on the nodes before or after the built-in provisioning steps.
+	ProvisionHooks []config.ProvisionHook
+	// ProvisionHooksConfigMap is the ConfigMap whose key `hooks.yaml` contains further provisioning hooks. It is read
+	// whenever user data is rendered, hence changes apply to nodes which are created afterwards.
+	ProvisionHooksConfigMap *types.NamespacedName
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return operatingsystemconfig.Add(mgr, operatingsystemconfig.AddArgs{
-		Actuator:          NewActuator(mgr),
+		Actuator:          NewActuator(mgr, opts.Actuator),
 		Predicates:        operatingsystemconfig.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Types:             []string{gardenlinux.OSTypeGardenLinux, memoryone.OSTypeMemoryOneGardenLinux},
 		ControllerOptions: opts.Controller,
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/validation"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+)
+
+const (
+	caCertificatesDir        = "/usr/local/share/ca-certificates"
+	caCertificatesScriptName = "update-ca-certificates.sh"
+
+	unitNameCACertificates = "gardener-ca-certificates.service"
+)
+
+var (
+	scriptContentCACertificates []byte
+
+	caCertificatesScriptPath = filepath.Join(gardenlinux.ScriptLocation, caCertificatesScriptName)
+)
+
+func init() {
+	var err error
+
+	scriptContentCACertificates, err = gardenlinux.Templates.ReadFile(filepath.Join("scripts", caCertificatesScriptName))
+	utilruntime.Must(err)
+}
+
+// caCertificates returns the tuning which adds the given CA bundles to the trust store. Bundles referencing a Secret
+// are read from the given namespace, so that the nodes do not need access to it. The unit updates the trust store
+// before containerd is started and restarts containerd if the trust store changes while it is running.
+func (a *actuator) caCertificates(ctx context.Context, namespace string, bundles []apisgardenlinux.CABundle) (tuning, error) {
+	var t tuning
+
+	if len(bundles) == 0 {
+		return t, nil
+	}
+
+	filePaths := []string{caCertificatesScriptPath}
+	for _, bundle := range bundles {
+		content, err := a.caBundleContent(ctx, namespace, bundle)
+		if err != nil {
+			return t, err
+		}
+
+		filePath := path.Join(caCertificatesDir, "gardener-"+bundle.Name+".crt")
+		filePaths = append(filePaths, filePath)
+		t.files = append(t.files, inlineFile(filePath, string(content)))
+	}
+
+	t.files = append(t.files, extensionsv1alpha1.File{
+		Path: caCertificatesScriptPath,
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data:     utils.EncodeBase64(scriptContentCACertificates),
+				Encoding: "b64",
+			},
+		},
+		Permissions: &gardenlinux.ScriptPermissions,
+	})
+	t.units = append(t.units, extensionsv1alpha1.Unit{
+		Name:    unitNameCACertificates,
+		Enable:  ptr.To(true),
+		Command: ptr.To(extensionsv1alpha1.CommandRestart),
+		Content: ptr.To(`[Unit]
+Description=Add the CA bundles of Gardener to the trust store
+Before=containerd.service
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=` + caCertificatesScriptPath + `
+[Install]
+WantedBy=multi-user.target
+`),
+		FilePaths: filePaths,
+	})
+	t.provision.Command(caCertificatesScriptPath)
+
+	return t, nil
+}
+
+// caBundleContent returns the validated content of the given CA bundle.
+func (a *actuator) caBundleContent(ctx context.Context, namespace string, bundle apisgardenlinux.CABundle) ([]byte, error) {
+	if bundle.Inline != nil {
+		return []byte(*bundle.Inline), nil
+	}
+
+	content, err := a.fileContent(ctx, namespace, &extensionsv1alpha1.FileContent{SecretRef: &extensionsv1alpha1.FileContentSecretRef{
+		Name:    bundle.SecretRef.Name,
+		DataKey: bundle.SecretRef.DataKey,
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle %q: %w", bundle.Name, err)
+	}
+
+	if err := validation.ValidateCertificateBundle(content); err != nil {
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("invalid CA bundle %q in key %q of secret %s/%s: %w", bundle.Name, bundle.SecretRef.DataKey, namespace, bundle.SecretRef.Name, err), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config/loader"
+)
+
+// defaultConfig is the defaulted ControllerConfiguration whose settings apply unless the ActuatorOptions set them.
+var defaultConfig *config.ControllerConfiguration
+
+func init() {
+	var err error
+
+	defaultConfig, err = loader.Default()
+	utilruntime.Must(err)
+}
+
+// applyControllerConfiguration sets the settings of the ControllerConfiguration of the given options in the actuator.
+func (a *actuator) applyControllerConfiguration(opts ActuatorOptions) {
+	nodeTuning := ptr.Deref(opts.NodeTuning, config.NodeTuning{})
+	a.defaultKernelModules = nodeTuning.KernelModules
+	if a.defaultKernelModules == nil {
+		a.defaultKernelModules = defaultConfig.NodeTuning.KernelModules
+	}
+	a.defaultSysctls = nodeTuning.Sysctls
+
+	containerd := ptr.Deref(opts.Containerd, config.Containerd{})
+	a.containerdLimitMEMLOCK = ptr.Deref(containerd.LimitMEMLOCK, *defaultConfig.Containerd.LimitMEMLOCK)
+	a.containerdLimitNOFILE = ptr.Deref(containerd.LimitNOFILE, *defaultConfig.Containerd.LimitNOFILE)
+
+	inPlaceUpdates := ptr.Deref(opts.InPlaceUpdates, config.InPlaceUpdates{})
+	a.inPlaceUpdateScriptPath = ptr.Deref(inPlaceUpdates.ScriptPath, *defaultConfig.InPlaceUpdates.ScriptPath)
+
+	a.staticProvisionHooks = opts.ProvisionHooks
+}
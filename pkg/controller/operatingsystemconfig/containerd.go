@@ -0,0 +1,363 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/structuredmap"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+	"k8s.io/utils/ptr"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+)
+
+const (
+	containerdBaseDir    = "/etc/containerd"
+	containerdConfigFile = containerdBaseDir + "/config.toml"
+	containerdConfigDir  = containerdBaseDir + "/conf.d"
+	containerdCertsDir   = containerdBaseDir + "/certs.d"
+
+	cniPluginDir = "/opt/cni/bin"
+)
+
+// containerdGeneration describes the configuration layout of a major version of containerd.
+type containerdGeneration struct {
+	// configVersion is the version of the configuration file.
+	configVersion int
+	// imagesPlugin is the path of the plugin which configures the image service of the CRI plugin.
+	imagesPlugin structuredmap.Path
+	// runtimePlugin is the path of the plugin which configures the runtime service of the CRI plugin.
+	runtimePlugin structuredmap.Path
+	// sandboxImage is the path of the sandbox image relative to the images plugin.
+	sandboxImage structuredmap.Path
+	// pluginPathReplacements are the prefixes of plugin paths of OperatingSystemConfigs which are replaced because
+	// they moved in this configuration version.
+	pluginPathReplacements []pathReplacement
+	// execConfigDropIn is the content of the drop-in of the containerd unit which starts containerd with the
+	// configuration of the extension.
+	execConfigDropIn string
+	// softLimitNOFILE is the soft limit of the number of open files of the containerd unit, which is inherited by the
+	// containers. If it is zero, the soft limit equals the configured hard limit.
+	softLimitNOFILE int64
+}
+
+// pathReplacement replaces the prefix of a path.
+type pathReplacement struct {
+	prefix      structuredmap.Path
+	replacement structuredmap.Path
+}
+
+var containerdGenerations = map[int32]containerdGeneration{
+	1: {
+		configVersion: 2,
+		imagesPlugin:  structuredmap.Path{"plugins", "io.containerd.grpc.v1.cri"},
+		runtimePlugin: structuredmap.Path{"plugins", "io.containerd.grpc.v1.cri"},
+		sandboxImage:  structuredmap.Path{"sandbox_image"},
+		execConfigDropIn: `[Service]
+ExecStart=
+ExecStart=/usr/bin/containerd --config=` + containerdConfigFile + `
+`,
+	},
+	2: {
+		configVersion: 3,
+		imagesPlugin:  structuredmap.Path{"plugins", "io.containerd.cri.v1.images"},
+		runtimePlugin: structuredmap.Path{"plugins", "io.containerd.cri.v1.runtime"},
+		sandboxImage:  structuredmap.Path{"pinned_images", "sandbox"},
+		// gardener-node-agent replaces the same prefix when it patches a configuration of version 3.
+		pluginPathReplacements: []pathReplacement{{
+			prefix:      structuredmap.Path{"io.containerd.grpc.v1.cri", "containerd", "runtimes"},
+			replacement: structuredmap.Path{"io.containerd.cri.v1.runtime", "containerd", "runtimes"},
+		}},
+		execConfigDropIn: `[Service]
+ExecStart=
+ExecStart=/usr/bin/containerd --config=` + containerdConfigFile + `
+`,
+		// The unit of containerd 2.x does not set LimitNOFILE anymore, so that containers get the default soft limit
+		// of systemd. containerd itself raises its soft limit to the hard limit.
+		softLimitNOFILE: 1024,
+	},
+}
+
+// containerdGenerationFor returns the generation of the given major version of containerd.
+func containerdGenerationFor(majorVersion int32) (containerdGeneration, error) {
+	generation, ok := containerdGenerations[majorVersion]
+	if !ok {
+		return containerdGeneration{}, fmt.Errorf("unsupported containerd major version %d", majorVersion)
+	}
+	return generation, nil
+}
+
+// limitsDropIn returns the drop-in of the containerd unit which sets the given maximum size of locked memory and
+// maximum number of open files of containerd.
+func (g containerdGeneration) limitsDropIn(limitMEMLOCK, limitNOFILE int64) string {
+	nofile := strconv.FormatInt(limitNOFILE, 10)
+	if g.softLimitNOFILE > 0 {
+		nofile = strconv.FormatInt(g.softLimitNOFILE, 10) + ":" + nofile
+	}
+
+	return fmt.Sprintf(`[Service]
+LimitMEMLOCK=%d
+LimitNOFILE=%s`, limitMEMLOCK, nofile)
+}
+
+// containerdMajorVersion returns the major version of containerd on the nodes of the given OperatingSystemConfig. It is
+// taken from the provider config if it is specified there. Otherwise, the nodes run containerd 2.x if the machine
+// image version of their worker pool is at least the configured minimum version and containerd 1.x if not.
+func (a *actuator) containerdMajorVersion(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, config *apisgardenlinux.OperatingSystemConfiguration) (int32, error) {
+	if config.Containerd != nil && config.Containerd.MajorVersion != nil {
+		return *config.Containerd.MajorVersion, nil
+	}
+
+	if a.containerdV2MinOSVersion == "" {
+		return 1, nil
+	}
+
+	shoot, err := extensionscontroller.GetShoot(ctx, a.client, osc.Namespace)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read shoot from cluster: %w", err)
+	}
+	if shoot == nil {
+		return 1, nil
+	}
+
+	pool := osc.Labels[v1beta1constants.LabelWorkerPool]
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Name != pool || worker.Machine.Image == nil || worker.Machine.Image.Version == nil {
+			continue
+		}
+
+		isV2, err := versionutils.CompareVersions(*worker.Machine.Image.Version, ">=", a.containerdV2MinOSVersion)
+		if err != nil {
+			return 0, fmt.Errorf("failed to compare machine image version of worker pool %q: %w", pool, err)
+		}
+		if isV2 {
+			return 2, nil
+		}
+	}
+
+	return 1, nil
+}
+
+// containerdFiles returns the containerd configuration for the given CRI configuration and major version of
+// containerd and the host configurations of its registries. Registries with readiness probes are left to
+// gardener-node-agent, which only adds them once their hosts are reachable.
+func containerdFiles(criConfig *extensionsv1alpha1.CRIConfig, majorVersion int32) ([]provisionFile, error) {
+	permissions := uint32(0644)
+
+	config, err := containerdConfig(criConfig, majorVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []provisionFile{{path: containerdConfigFile, content: config, permissions: &permissions}}
+
+	if criConfig == nil || criConfig.Containerd == nil {
+		return files, nil
+	}
+
+	for _, registry := range criConfig.Containerd.Registries {
+		if ptr.Deref(registry.ReadinessProbe, false) {
+			continue
+		}
+
+		files = append(files, provisionFile{
+			path:        path.Join(containerdCertsDir, registry.Upstream, "hosts.toml"),
+			content:     containerdHostsConfig(registry),
+			permissions: &permissions,
+		})
+	}
+
+	return files, nil
+}
+
+// containerdConfig renders a complete containerd configuration in the layout of the given major version of containerd
+// for the given CRI configuration. It contains the same settings which gardener-node-agent patches into the
+// configuration on reconcile, i.e. the registry config path, the sandbox image, the cgroup driver and the plugin
+// configuration, e.g. of the runtime handlers. Settings which are not contained fall back to the defaults of
+// containerd. Keys are rendered in sorted order, hence the configuration is deterministic.
+func containerdConfig(criConfig *extensionsv1alpha1.CRIConfig, majorVersion int32) ([]byte, error) {
+	generation, err := containerdGenerationFor(majorVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{
+		"version": generation.configVersion,
+		"imports": []string{path.Join(containerdConfigDir, "*.toml")},
+	}
+
+	type setting struct {
+		path  structuredmap.Path
+		value any
+	}
+
+	settings := []setting{
+		{slices.Concat(generation.imagesPlugin, structuredmap.Path{"registry", "config_path"}), containerdCertsDir},
+		{slices.Concat(generation.runtimePlugin, structuredmap.Path{"cni", "bin_dir"}), cniPluginDir},
+		{slices.Concat(generation.runtimePlugin, structuredmap.Path{"containerd", "default_runtime_name"}), "runc"},
+		{slices.Concat(generation.runtimePlugin, structuredmap.Path{"containerd", "runtimes", "runc", "runtime_type"}), "io.containerd.runc.v2"},
+	}
+	if criConfig != nil && criConfig.CgroupDriver != nil {
+		settings = append(settings, setting{
+			slices.Concat(generation.runtimePlugin, structuredmap.Path{"containerd", "runtimes", "runc", "options", "SystemdCgroup"}),
+			*criConfig.CgroupDriver == extensionsv1alpha1.CgroupDriverSystemd,
+		})
+	}
+	if criConfig != nil && criConfig.Containerd != nil && criConfig.Containerd.SandboxImage != "" {
+		settings = append(settings, setting{slices.Concat(generation.imagesPlugin, generation.sandboxImage), criConfig.Containerd.SandboxImage})
+	}
+
+	for _, s := range settings {
+		if err := structuredmap.SetMapEntry(config, s.path, func(_ any) (any, error) {
+			return s.value, nil
+		}); err != nil {
+			return nil, fmt.Errorf("failed setting %q in containerd config: %w", strings.Join(s.path, "."), err)
+		}
+	}
+
+	if criConfig != nil && criConfig.Containerd != nil {
+		for _, plugin := range criConfig.Containerd.Plugins {
+			if err := structuredmap.SetMapEntry(config, append(structuredmap.Path{"plugins"}, generation.pluginPath(plugin.Path)...), func(value any) (any, error) {
+				return containerdPluginValues(plugin, value)
+			}); err != nil {
+				return nil, fmt.Errorf("failed setting plugin configuration %q in containerd config: %w", strings.Join(plugin.Path, "."), err)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(withoutNilValues(config)); err != nil {
+		return nil, fmt.Errorf("failed to render containerd config: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// pluginPath returns the given plugin path of an OperatingSystemConfig in the layout of this generation.
+func (g containerdGeneration) pluginPath(pluginPath []string) []string {
+	for _, r := range g.pluginPathReplacements {
+		if len(pluginPath) >= len(r.prefix) && slices.Equal(pluginPath[:len(r.prefix)], r.prefix) {
+			return slices.Concat(r.replacement, pluginPath[len(r.prefix):])
+		}
+	}
+
+	return pluginPath
+}
+
+// containerdPluginValues returns the values of the given plugin configuration merged into the given existing values
+// like gardener-node-agent merges them.
+func containerdPluginValues(plugin extensionsv1alpha1.PluginConfig, value any) (any, error) {
+	switch op := ptr.Deref(plugin.Op, extensionsv1alpha1.AddPluginPathOperation); op {
+	case extensionsv1alpha1.AddPluginPathOperation:
+		values, ok := value.(map[string]any)
+		if !ok || values == nil {
+			values = map[string]any{}
+		}
+
+		if plugin.Values == nil {
+			return values, nil
+		}
+
+		// Numbers are decoded as json.Number and converted afterwards, so that integers are not rendered as floats.
+		decoder := json.NewDecoder(bytes.NewReader(plugin.Values.Raw))
+		decoder.UseNumber()
+
+		var pluginValues map[string]any
+		if err := decoder.Decode(&pluginValues); err != nil {
+			return nil, err
+		}
+
+		for k, v := range pluginValues {
+			values[k] = withTOMLNumbers(v)
+		}
+
+		return values, nil
+	case extensionsv1alpha1.RemovePluginPathOperation:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("operation %q is not supported", op)
+	}
+}
+
+// withTOMLNumbers converts the json.Number values of the given decoded JSON value into integers or floats.
+func withTOMLNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for key, val := range v {
+			v[key] = withTOMLNumbers(val)
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = withTOMLNumbers(val)
+		}
+	}
+
+	return value
+}
+
+// withoutNilValues removes the removed (nil) entries of the given map recursively.
+func withoutNilValues(m map[string]any) map[string]any {
+	for key, value := range m {
+		switch v := value.(type) {
+		case nil:
+			delete(m, key)
+		case map[string]any:
+			m[key] = withoutNilValues(v)
+		}
+	}
+
+	return m
+}
+
+// containerdHostsConfig renders the hosts.toml file of the given registry like gardener-node-agent. The hosts are
+// rendered in the given order because containerd tries them in this order.
+func containerdHostsConfig(registry extensionsv1alpha1.RegistryConfig) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("# managed by gardener-node-agent\n")
+	if registry.Server != nil && *registry.Server != "" {
+		fmt.Fprintf(&buf, "server = %s\n\n", tomlValue(*registry.Server))
+	}
+
+	for _, host := range registry.Hosts {
+		capabilities := host.Capabilities
+		if len(capabilities) == 0 {
+			capabilities = []extensionsv1alpha1.RegistryCapability{extensionsv1alpha1.PullCapability, extensionsv1alpha1.ResolveCapability}
+		}
+
+		fmt.Fprintf(&buf, "[host.%s]\n", tomlValue(host.URL))
+		fmt.Fprintf(&buf, "  capabilities = %s\n", tomlValue(capabilities))
+		if len(host.CACerts) > 0 {
+			fmt.Fprintf(&buf, "  ca = %s\n", tomlValue(host.CACerts))
+		}
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes()
+}
+
+// tomlValue renders the given string or string slice as TOML value. JSON strings and arrays of strings are valid TOML.
+func tomlValue(value any) string {
+	out, _ := json.Marshal(value) // #nosec G104 -- strings and string slices can always be marshalled
+	return string(out)
+}
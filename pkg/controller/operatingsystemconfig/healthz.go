@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+// NewFirstSyncChecker returns a healthz.Checker which passes once the OSC controller has completed its first sync, i.e.
+// the informer of OperatingSystemConfigs which the controller watches has listed all objects, so that the controller
+// has queued them. Once passed, the check always passes.
+func NewFirstSyncChecker(informers cache.Informers) healthz.Checker {
+	var synced atomic.Bool
+
+	return func(req *http.Request) error {
+		if synced.Load() {
+			return nil
+		}
+
+		informer, err := informers.GetInformer(req.Context(), &extensionsv1alpha1.OperatingSystemConfig{}, cache.BlockUntilSynced(false))
+		if err != nil {
+			return fmt.Errorf("failed to get informer of OperatingSystemConfigs: %w", err)
+		}
+		if !informer.HasSynced() {
+			return errors.New("the OperatingSystemConfig controller has not completed its first sync yet")
+		}
+
+		synced.Store(true)
+		return nil
+	}
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig_test
+
+import (
+	"net/http"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	. "github.com/gardener/gardener-extension-os-gardenlinux/pkg/controller/operatingsystemconfig"
+)
+
+var _ = Describe("Healthz", func() {
+	Describe("#NewFirstSyncChecker", func() {
+		var (
+			informer *controllertest.FakeInformer
+			checker  healthz.Checker
+			req      *http.Request
+		)
+
+		BeforeEach(func() {
+			informer = &controllertest.FakeInformer{}
+			checker = NewFirstSyncChecker(&informertest.FakeInformers{
+				InformersByGVK: map[schema.GroupVersionKind]toolscache.SharedIndexInformer{
+					extensionsv1alpha1.SchemeGroupVersion.WithKind("OperatingSystemConfig"): informer,
+				},
+				Scheme: kubernetes.SeedScheme,
+			})
+			req = &http.Request{}
+		})
+
+		It("should fail as long as the informer of OperatingSystemConfigs has not synced", func() {
+			Expect(checker(req)).To(MatchError(ContainSubstring("has not completed its first sync yet")))
+		})
+
+		It("should pass once the informer of OperatingSystemConfigs has synced and keep passing", func() {
+			informer.Synced = true
+			Expect(checker(req)).To(Succeed())
+
+			informer.Synced = false
+			Expect(checker(req)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	configv1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config/v1alpha1"
+	configvalidation "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config/validation"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/internal/shellscript"
+)
+
+const (
+	// ProvisionHooksConfigMapKey is the key of the ConfigMap which contains the provisioning hooks.
+	ProvisionHooksConfigMapKey = "hooks.yaml"
+
+	// provisionHooksLocation is the directory to which the scripts of the provisioning hooks are written.
+	provisionHooksLocation = "/opt/gardener/hooks"
+)
+
+// provisionHooksDocument is the content of the key of the ConfigMap which contains the provisioning hooks.
+type provisionHooksDocument struct {
+	// Hooks are the provisioning hooks in the format of the ControllerConfiguration.
+	Hooks []configv1alpha1.ProvisionHook `json:"hooks"`
+}
+
+// decodeProvisionHooks decodes and validates the given provisioning hooks.
+func decodeProvisionHooks(data []byte) ([]config.ProvisionHook, error) {
+	document := &provisionHooksDocument{}
+	if err := yaml.UnmarshalStrict(data, document); err != nil {
+		return nil, fmt.Errorf("failed to decode provisioning hooks: %w", err)
+	}
+
+	hooks := make([]config.ProvisionHook, len(document.Hooks))
+	for i := range document.Hooks {
+		if err := configv1alpha1.Convert_v1alpha1_ProvisionHook_To_config_ProvisionHook(&document.Hooks[i], &hooks[i], nil); err != nil {
+			return nil, fmt.Errorf("failed to convert provisioning hooks: %w", err)
+		}
+	}
+
+	if errs := configvalidation.ValidateProvisionHooks(hooks, field.NewPath("hooks")); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid provisioning hooks: %w", errs.ToAggregate())
+	}
+
+	return hooks, nil
+}
+
+// provisionHookMatches returns true if the given hook runs for the given OperatingSystemConfig.
+func provisionHookMatches(hook config.ProvisionHook, osc *extensionsv1alpha1.OperatingSystemConfig) bool {
+	return (len(hook.OSTypes) == 0 || slices.Contains(hook.OSTypes, osc.Spec.Type)) &&
+		(len(hook.WorkerPools) == 0 || slices.Contains(hook.WorkerPools, osc.Labels[v1beta1constants.LabelWorkerPool]))
+}
+
+// provisionHooks returns the provisioning hooks which run for the given OperatingSystemConfig. These are the hooks of
+// the actuator options followed by the hooks of the ConfigMap, if it exists.
+func (a *actuator) provisionHooks(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) ([]config.ProvisionHook, error) {
+	hooks := a.staticProvisionHooks
+
+	if a.provisionHooksConfigMap != nil {
+		configMapHooks, err := a.configMapProvisionHooks(ctx, *a.provisionHooksConfigMap)
+		if err != nil {
+			return nil, err
+		}
+		hooks = slices.Concat(hooks, configMapHooks)
+	}
+
+	var matching []config.ProvisionHook
+	for _, hook := range hooks {
+		if provisionHookMatches(hook, osc) {
+			matching = append(matching, hook)
+		}
+	}
+	return matching, nil
+}
+
+// configMapProvisionHooks reads the provisioning hooks from the given ConfigMap. A missing ConfigMap contains no hooks.
+func (a *actuator) configMapProvisionHooks(ctx context.Context, key types.NamespacedName) ([]config.ProvisionHook, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := a.client.Get(ctx, key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read provisioning hooks from configmap %s: %w", key, err)
+	}
+
+	data, ok := configMap.Data[ProvisionHooksConfigMapKey]
+	if !ok {
+		return nil, fmt.Errorf("configmap %s does not contain key %q with the provisioning hooks", key, ProvisionHooksConfigMapKey)
+	}
+
+	hooks, err := decodeProvisionHooks([]byte(data))
+	if err != nil {
+		return nil, fmt.Errorf("configmap %s: %w", key, err)
+	}
+
+	for _, hook := range hooks {
+		if slices.ContainsFunc(a.staticProvisionHooks, func(h config.ProvisionHook) bool { return h.Name == hook.Name }) {
+			return nil, fmt.Errorf("configmap %s: provisioning hook %q is already defined in the configuration of the extension", key, hook.Name)
+		}
+	}
+
+	return hooks, nil
+}
+
+// provisionHookSteps returns the scripts of those of the given hooks which run at the given point and the commands
+// which run them, each reported as step `hook-<name>`.
+func provisionHookSteps(hooks []config.ProvisionHook, point config.ProvisionHookPoint) ([]provisionFile, *shellscript.Script) {
+	var (
+		files    []provisionFile
+		commands = shellscript.New()
+	)
+
+	for _, hook := range hooks {
+		if hook.Point != point {
+			continue
+		}
+
+		script := hook.Script
+		if !strings.HasPrefix(script, "#!") {
+			script = "#!/bin/bash\n" + script
+		}
+
+		scriptPath := path.Join(provisionHooksLocation, hook.Name+".sh")
+		files = append(files, provisionFile{
+			path:        scriptPath,
+			content:     []byte(script),
+			permissions: &gardenlinux.ScriptPermissions,
+		})
+		reportStep(commands, "hook-"+hook.Name, shellscript.New().RunOrExit(scriptPath))
+	}
+
+	return files, commands
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+)
+
+const (
+	ignitionVersion = "3.3.0"
+
+	unitNameProvisionOSC = "gardener-provision-osc.service"
+)
+
+var (
+	filePathProvisionOSCScript = filepath.Join(gardenlinux.ScriptLocation, "provision-osc.sh")
+
+	unitContentProvisionOSC = `[Unit]
+Description=Provisions the node with the OperatingSystemConfig
+Wants=network-online.target
+After=network-online.target
+ConditionPathExists=!/var/lib/osc/provision-osc-applied
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=` + filePathProvisionOSCScript + `
+
+[Install]
+WantedBy=multi-user.target
+`
+)
+
+// ignitionConfig is the subset of an Ignition spec v3 config which is used to provision the nodes.
+type ignitionConfig struct {
+	Ignition ignitionMetadata `json:"ignition"`
+	Storage  ignitionStorage  `json:"storage,omitempty"`
+	Systemd  ignitionSystemd  `json:"systemd,omitempty"`
+}
+
+type ignitionMetadata struct {
+	Version string `json:"version"`
+}
+
+type ignitionStorage struct {
+	Files []ignitionFile `json:"files,omitempty"`
+}
+
+type ignitionFile struct {
+	Path      string           `json:"path"`
+	Contents  ignitionContents `json:"contents"`
+	Mode      *int             `json:"mode,omitempty"`
+	Overwrite *bool            `json:"overwrite,omitempty"`
+}
+
+type ignitionContents struct {
+	Source      string `json:"source"`
+	Compression string `json:"compression,omitempty"`
+}
+
+type ignitionSystemd struct {
+	Units []ignitionUnit `json:"units,omitempty"`
+}
+
+type ignitionUnit struct {
+	Name     string           `json:"name"`
+	Enabled  *bool            `json:"enabled,omitempty"`
+	Contents *string          `json:"contents,omitempty"`
+	Dropins  []ignitionDropIn `json:"dropins,omitempty"`
+}
+
+type ignitionDropIn struct {
+	Name     string `json:"name"`
+	Contents string `json:"contents"`
+}
+
+// ignition renders the files and units into an Ignition spec v3 config. Ignition cannot run commands, hence they are
+// written into a script which is run once by an enabled oneshot unit after the network is online. If compress is true,
+// the contents of the files are compressed with gzip.
+func (d *provisionData) ignition(compress bool) (string, error) {
+	config := ignitionConfig{Ignition: ignitionMetadata{Version: ignitionVersion}}
+
+	for _, file := range slices.Concat(d.allFiles(), []provisionFile{{
+		path:        filePathProvisionOSCScript,
+		content:     []byte(d.script()),
+		permissions: &gardenlinux.ScriptPermissions,
+	}}) {
+		f := ignitionFile{
+			Path:      file.path,
+			Contents:  ignitionContents{Source: "data:;base64," + utils.EncodeBase64(file.content)},
+			Overwrite: ptr.To(true),
+		}
+		if compress {
+			compressed, err := gzipData(file.content)
+			if err != nil {
+				return "", err
+			}
+			f.Contents = ignitionContents{Source: "data:;base64," + utils.EncodeBase64(compressed), Compression: "gzip"}
+		}
+		if file.permissions != nil {
+			f.Mode = ptr.To(int(*file.permissions))
+		}
+		config.Storage.Files = append(config.Storage.Files, f)
+	}
+
+	// Ignition rejects duplicate units, hence drop-ins of units with the same name are merged. Units without content
+	// and drop-ins are only enabled or disabled by the provisioning script.
+	unitIndex := make(map[string]int)
+	for _, unit := range d.units {
+		if unit.Content == nil && len(unit.DropIns) == 0 {
+			continue
+		}
+
+		i, ok := unitIndex[unit.Name]
+		if !ok {
+			i = len(config.Systemd.Units)
+			unitIndex[unit.Name] = i
+			config.Systemd.Units = append(config.Systemd.Units, ignitionUnit{Name: unit.Name})
+		}
+
+		if unit.Content != nil {
+			config.Systemd.Units[i].Contents = unit.Content
+		}
+		for _, dropIn := range unit.DropIns {
+			config.Systemd.Units[i].Dropins = append(config.Systemd.Units[i].Dropins, ignitionDropIn{Name: dropIn.Name, Contents: dropIn.Content})
+		}
+	}
+
+	config.Systemd.Units = append(config.Systemd.Units, ignitionUnit{
+		Name:     unitNameProvisionOSC,
+		Enabled:  ptr.To(true),
+		Contents: &unitContentProvisionOSC,
+	})
+
+	out, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to render ignition config: %w", err)
+	}
+
+	return string(out), nil
+}
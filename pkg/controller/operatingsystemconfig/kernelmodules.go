@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+)
+
+const (
+	kernelModulesLoadFilePath      = "/etc/modules-load.d/gardener.conf"
+	kernelModulesBlacklistFilePath = "/etc/modprobe.d/gardener-blacklist.conf"
+
+	unitNameModulesLoad = "systemd-modules-load.service"
+)
+
+// kernelModules returns the tuning which loads and blacklists the given kernel modules.
+func kernelModules(modules *apisgardenlinux.KernelModules) tuning {
+	var t tuning
+
+	if modules == nil {
+		return t
+	}
+
+	if len(modules.Load) > 0 {
+		t.files = append(t.files, inlineFile(kernelModulesLoadFilePath, strings.Join(modules.Load, "\n")+"\n"))
+		t.units = append(t.units, extensionsv1alpha1.Unit{
+			Name:      unitNameModulesLoad,
+			FilePaths: []string{kernelModulesLoadFilePath},
+		})
+		for _, name := range modules.Load {
+			t.provision.LoadModule(name)
+		}
+	}
+
+	if len(modules.Blacklist) > 0 {
+		var content strings.Builder
+		for _, name := range modules.Blacklist {
+			content.WriteString("blacklist " + name + "\n")
+		}
+		t.files = append(t.files, inlineFile(kernelModulesBlacklistFilePath, content.String()))
+	}
+
+	return t
+}
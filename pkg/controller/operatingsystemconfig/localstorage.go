@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/api/resource"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/internal/shellscript"
+)
+
+const (
+	localStorageScriptName = "setup-local-storage.sh"
+	localStorageConfigPath = "/etc/gardener/local-storage.conf"
+	localStorageDevice     = "/dev/disk/by-label/gardener-md"
+
+	unitNameLocalStorage = "gardener-local-storage.service"
+
+	defaultLocalStorageMountPoint = "/mnt/local-storage"
+)
+
+var (
+	scriptContentLocalStorage []byte
+
+	localStorageScriptPath = filepath.Join(gardenlinux.ScriptLocation, localStorageScriptName)
+
+	defaultLocalStorageDirectories = []string{"/var/lib/containerd", "/var/lib/kubelet"}
+)
+
+func init() {
+	var err error
+
+	scriptContentLocalStorage, err = gardenlinux.Templates.ReadFile(filepath.Join("scripts", localStorageScriptName))
+	utilruntime.Must(err)
+}
+
+// localStorage returns the tuning which combines the selected local disks into a RAID0 array, mounts it and
+// bind-mounts the directories from it. The mounts are started during provisioning before containerd is started, later
+// changes of the configuration cannot move the data of running nodes and only apply to new nodes.
+func localStorage(config *apisgardenlinux.LocalStorage) tuning {
+	var t tuning
+
+	if config == nil {
+		return t
+	}
+
+	var (
+		mountPoint     = ptr.Deref(config.MountPoint, defaultLocalStorageMountPoint)
+		filesystem     = ptr.Deref(config.Filesystem, apisgardenlinux.FilesystemExt4)
+		directories    = config.Directories
+		mountUnitName  = systemdEscapePath(mountPoint) + ".mount"
+		bindMountUnits []string
+		subdirectories []string
+		settings       strings.Builder
+	)
+	if directories == nil {
+		directories = defaultLocalStorageDirectories
+	}
+
+	for _, directory := range directories {
+		subdirectories = append(subdirectories, systemdEscapePath(directory))
+	}
+
+	fmt.Fprintf(&settings, "MOUNT_POINT=%s\n", shellscript.Quote(mountPoint))
+	fmt.Fprintf(&settings, "FILESYSTEM=%s\n", shellscript.Quote(string(filesystem)))
+	fmt.Fprintf(&settings, "MIN_SIZE=%s\n", diskSize(config.Disks.MinSize))
+	fmt.Fprintf(&settings, "MAX_SIZE=%s\n", diskSize(config.Disks.MaxSize))
+	writeShellArray(&settings, "MODELS", config.Disks.Models)
+	writeShellArray(&settings, "PATHS", config.Disks.Paths)
+	writeShellArray(&settings, "DIRECTORIES", subdirectories)
+
+	t.files = append(t.files,
+		extensionsv1alpha1.File{
+			Path: localStorageScriptPath,
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Data:     utils.EncodeBase64(scriptContentLocalStorage),
+					Encoding: "b64",
+				},
+			},
+			Permissions: &gardenlinux.ScriptPermissions,
+		},
+		inlineFile(localStorageConfigPath, settings.String()),
+	)
+
+	// The mounts only want the setup unit, so that restarting it when its configuration changes does not unmount the
+	// storage of a running node.
+	t.units = append(t.units,
+		extensionsv1alpha1.Unit{
+			Name: unitNameLocalStorage,
+			Content: ptr.To(`[Unit]
+Description=Set up the local storage of the node
+DefaultDependencies=no
+After=local-fs-pre.target systemd-udevd.service
+Before=` + mountUnitName + `
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=` + localStorageScriptPath + ` ` + localStorageConfigPath + `
+[Install]
+WantedBy=local-fs.target
+`),
+			FilePaths: []string{localStorageScriptPath, localStorageConfigPath},
+		},
+		extensionsv1alpha1.Unit{
+			Name: mountUnitName,
+			Content: ptr.To(`[Unit]
+Description=Local storage of the node
+Wants=` + unitNameLocalStorage + `
+After=` + unitNameLocalStorage + `
+[Mount]
+What=` + localStorageDevice + `
+Where=` + mountPoint + `
+Type=` + string(filesystem) + `
+Options=noatime
+[Install]
+WantedBy=local-fs.target
+`),
+		},
+	)
+
+	for i, directory := range directories {
+		name := subdirectories[i] + ".mount"
+		bindMountUnits = append(bindMountUnits, name)
+		t.units = append(t.units, extensionsv1alpha1.Unit{
+			Name: name,
+			Content: ptr.To(`[Unit]
+Description=Bind mount of ` + directory + ` from the local storage of the node
+RequiresMountsFor=` + mountPoint + `
+Before=containerd.service kubelet.service
+[Mount]
+What=` + path.Join(mountPoint, subdirectories[i]) + `
+Where=` + directory + `
+Type=none
+Options=bind
+[Install]
+WantedBy=local-fs.target
+`),
+		})
+	}
+
+	t.startedUnits = sets.New[string]()
+	t.provision.Command(daemonReloadCommand)
+	for _, unit := range t.units {
+		t.provision.EnableUnit(unit.Name)
+		t.startedUnits.Insert(unit.Name)
+	}
+	t.provision.RunOrExit("systemctl", append([]string{"start"}, bindMountUnits...)...)
+
+	return t
+}
+
+// diskSize returns the given size in bytes or an empty string if it is not set.
+func diskSize(size *resource.Quantity) string {
+	if size == nil {
+		return ""
+	}
+	return strconv.FormatInt(size.Value(), 10)
+}
+
+func writeShellArray(out *strings.Builder, name string, values []string) {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, shellscript.Quote(value))
+	}
+	fmt.Fprintf(out, "%s=(%s)\n", name, strings.Join(quoted, " "))
+}
+
+// systemdEscapePath escapes the given absolute path like `systemd-escape --path`, e.g. for the names of mount units.
+func systemdEscapePath(p string) string {
+	p = strings.Trim(path.Clean(p), "/")
+	if p == "" {
+		return "-"
+	}
+
+	var out strings.Builder
+	for i := 0; i < len(p); i++ {
+		switch c := p[i]; {
+		case c == '/':
+			out.WriteByte('-')
+		case c == '.' && i == 0,
+			!(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == ':' || c == '_' || c == '.'):
+			fmt.Fprintf(&out, `\x%02x`, c)
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return out.String()
+}
@@ -6,11 +6,16 @@ package operatingsystemconfig
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	apismemoryonegardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux"
 	memoryonegardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/v1alpha1"
+	memoryonevalidation "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/validation"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/memoryone"
 )
 
@@ -19,65 +24,98 @@ const (
 	systemMemory   = "system_memory"
 )
 
-func wrapIntoMemoryOneHeaderAndFooter(osc *extensionsv1alpha1.OperatingSystemConfig, in string) (string, error) {
+func wrapIntoMemoryOneHeaderAndFooter(osc *extensionsv1alpha1.OperatingSystemConfig, parts ...mimePart) (string, error) {
 	config, err := memoryone.Configuration(osc)
 	if err != nil {
 		return "", err
 	}
 
+	topology, memory := memoryOneParameters(config)
+	recordMemoryOneConfiguration(osc, topology, memory)
+
 	memoryOneConfiguration := vsmpConfigString(config)
 
-	out := `Content-Type: multipart/mixed; boundary="==BOUNDARY=="
-MIME-Version: 1.0
---==BOUNDARY==
-Content-Type: text/x-vsmp; section=vsmp
+	return mimeMultipart(append([]mimePart{{
+		contentType: "text/x-vsmp; section=vsmp",
+		content:     strings.TrimSuffix(memoryOneConfiguration, "\n"),
+	}}, parts...)...), nil
+}
 
-` + memoryOneConfiguration + `--==BOUNDARY==
-Content-Type: text/x-shellscript
+// vsmpConfigString renders the vSMP parameters of the given configuration as `key=value` lines in a stable order, so
+// that the user data does not change as long as the configuration does not change: the legacy parameters
+// `mem_topology` and `system_memory` come first, followed by all other parameters sorted by their keys.
+func vsmpConfigString(config *memoryonegardenlinux.OperatingSystemConfiguration) string {
+	var (
+		configStringBuilder strings.Builder
+		parameters          = vsmpParameters(config)
+		keys                = []string{memoryTopology, systemMemory}
+	)
+
+	for _, k := range slices.Sorted(maps.Keys(parameters)) {
+		if k != memoryTopology && k != systemMemory {
+			keys = append(keys, k)
+		}
+	}
 
-` + in + `
---==BOUNDARY==
-`
+	for _, k := range keys {
+		fmt.Fprintf(&configStringBuilder, "%s=%s\n", k, parameters[k])
+	}
 
-	return out, nil
+	return configStringBuilder.String()
 }
 
-func vsmpConfigString(config *memoryonegardenlinux.OperatingSystemConfiguration) string {
-	var vsmpConfiguration map[string]string
-	var configStringBuilder strings.Builder
+// vsmpParameters returns the vSMP parameters of the given configuration after defaulting.
+func vsmpParameters(config *memoryonegardenlinux.OperatingSystemConfiguration) map[string]string {
+	vsmpConfiguration := make(map[string]string, 2)
 
-	if config != nil && config.VsmpConfiguration != nil {
-		vsmpConfiguration = config.VsmpConfiguration
-		// TODO: put stripSemicola down into the StringBuilder-Fprintf and remove this loop once we end support for legacy values
+	if config != nil {
+		// TODO: put stripSemicola down into the StringBuilder-Fprintf and remove stripping the values once we end support for legacy values
 		// this is required as we do not want to allow injecting key-value pairs with semicola in the new parameter map
 		// but need to retain the previous behaviour for the legacy configuration style
-		for k, v := range vsmpConfiguration {
-			vsmpConfiguration[k] = stripSemicola(v)
+		for k, v := range config.VsmpConfiguration {
+			vsmpConfiguration[stripSemicola(k)] = stripSemicola(v)
 		}
-	} else {
-		vsmpConfiguration = make(map[string]string, 2)
 	}
 
 	// TODO: remove these once the transition to VsmpConfiguration map[string]string is complete
-	vsmpConfiguration[memoryTopology] = "2"
-	vsmpConfiguration[systemMemory] = "6x"
+	vsmpConfiguration[memoryTopology], vsmpConfiguration[systemMemory] = memoryOneParameters(config)
+	// end TODO
 
-	if config != nil {
-		if config.SystemMemory != nil {
-			vsmpConfiguration[systemMemory] = *config.SystemMemory
-		}
+	return vsmpConfiguration
+}
 
+// vsmpWarnings returns the warnings about the vSMP parameters of the given configuration, e.g. unknown parameters which
+// are passed through to vSMP without validation. The admission webhook cannot return them to the user, hence they are
+// published in the provider status of the OperatingSystemConfig.
+func vsmpWarnings(config *memoryonegardenlinux.OperatingSystemConfiguration) ([]string, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	internalConfig := &apismemoryonegardenlinux.OperatingSystemConfiguration{}
+	if err := memoryonegardenlinux.Convert_v1alpha1_OperatingSystemConfiguration_To_memoryonegardenlinux_OperatingSystemConfiguration(config, internalConfig, nil); err != nil {
+		return nil, err
+	}
+
+	return memoryonevalidation.WarningsForOperatingSystemConfig(internalConfig, field.NewPath("spec", "providerConfig")), nil
+}
+
+// memoryOneParameters returns the memory topology and the system memory of the given configuration, or their defaults
+// if they are not configured.
+func memoryOneParameters(config *memoryonegardenlinux.OperatingSystemConfiguration) (string, string) {
+	topology, memory := "2", "6x"
+
+	if config != nil {
 		if config.MemoryTopology != nil {
-			vsmpConfiguration[memoryTopology] = *config.MemoryTopology
+			topology = *config.MemoryTopology
 		}
-	}
-	// end TODO
 
-	for k, v := range vsmpConfiguration {
-		fmt.Fprintf(&configStringBuilder, "%s=%s\n", stripSemicola(k), v)
+		if config.SystemMemory != nil {
+			memory = *config.SystemMemory
+		}
 	}
 
-	return configStringBuilder.String()
+	return topology, memory
 }
 
 func stripSemicola(s string) string {
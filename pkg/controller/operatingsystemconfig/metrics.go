@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"errors"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+)
+
+const (
+	metricsNamespace = "os_gardenlinux"
+	metricsSubsystem = "operatingsystemconfig"
+
+	resultSuccess = "success"
+	resultError   = "error"
+
+	failureReasonDecode     = "decode"
+	failureReasonValidation = "validation"
+)
+
+var (
+	reconcilesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "reconciles_total",
+		Help:      "Number of reconciliations of OperatingSystemConfigs per OS type, purpose and result.",
+	}, []string{"os_type", "purpose", "result"})
+
+	renderDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "render_duration_seconds",
+		Help:      "Duration in seconds of rendering the user data or the files and units of OperatingSystemConfigs per OS type and purpose.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 12),
+	}, []string{"os_type", "purpose"})
+
+	userDataSizeBytes = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "user_data_size_bytes",
+		Help:      "Size in bytes of the rendered user data per OS type and user data format.",
+		Buckets:   prometheus.ExponentialBuckets(1024, 2, 11),
+	}, []string{"os_type", "format"})
+
+	renderedFiles = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "files",
+		Help:      "Number of files which are rendered for an OperatingSystemConfig.",
+	}, []string{"namespace", "name", "purpose"})
+
+	renderedUnits = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "units",
+		Help:      "Number of units which are rendered for an OperatingSystemConfig.",
+	}, []string{"namespace", "name", "purpose"})
+
+	memoryOneConfigurationInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "memoryone_configuration_info",
+		Help:      "Effective MemoryOne configuration of an OperatingSystemConfig of type memoryone-gardenlinux, always 1.",
+	}, []string{"namespace", "name", "memory_topology", "system_memory"})
+
+	inPlaceUpdateTargetVersionInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "inplace_update_target_version_info",
+		Help:      "OS version to which an OperatingSystemConfig requests an in-place update, always 1.",
+	}, []string{"namespace", "name", "version"})
+
+	providerConfigFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "provider_config_failures_total",
+		Help:      "Number of provider configs of OperatingSystemConfigs which could not be decoded or are invalid per OS type and reason.",
+	}, []string{"os_type", "reason"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		reconcilesTotal,
+		renderDurationSeconds,
+		userDataSizeBytes,
+		renderedFiles,
+		renderedUnits,
+		memoryOneConfigurationInfo,
+		inPlaceUpdateTargetVersionInfo,
+		providerConfigFailuresTotal,
+	)
+}
+
+// recordReconcile records a reconciliation of the given OperatingSystemConfig which took the given duration and failed
+// with the given error, if any.
+func recordReconcile(osc *extensionsv1alpha1.OperatingSystemConfig, duration time.Duration, err error) {
+	purpose := string(osc.Spec.Purpose)
+
+	result := resultSuccess
+	if err != nil {
+		result = resultError
+	}
+	reconcilesTotal.WithLabelValues(osc.Spec.Type, purpose, result).Inc()
+	renderDurationSeconds.WithLabelValues(osc.Spec.Type, purpose).Observe(duration.Seconds())
+
+	switch {
+	case errors.Is(err, gardenlinux.ErrDecodeProviderConfig):
+		providerConfigFailuresTotal.WithLabelValues(osc.Spec.Type, failureReasonDecode).Inc()
+	case errors.Is(err, gardenlinux.ErrInvalidProviderConfig):
+		providerConfigFailuresTotal.WithLabelValues(osc.Spec.Type, failureReasonValidation).Inc()
+	}
+}
+
+// recordContent records the number of files and units which are rendered for the given OperatingSystemConfig.
+func recordContent(osc *extensionsv1alpha1.OperatingSystemConfig, fileCount, unitCount int) {
+	renderedFiles.WithLabelValues(osc.Namespace, osc.Name, string(osc.Spec.Purpose)).Set(float64(fileCount))
+	renderedUnits.WithLabelValues(osc.Namespace, osc.Name, string(osc.Spec.Purpose)).Set(float64(unitCount))
+}
+
+// recordUserData records the size of the user data which is rendered in the given format for the given
+// OperatingSystemConfig.
+func recordUserData(osc *extensionsv1alpha1.OperatingSystemConfig, format apisgardenlinux.UserDataFormat, userData string) {
+	userDataSizeBytes.WithLabelValues(osc.Spec.Type, string(format)).Observe(float64(len(userData)))
+}
+
+// recordMemoryOneConfiguration records the effective MemoryOne configuration of the given OperatingSystemConfig.
+func recordMemoryOneConfiguration(osc *extensionsv1alpha1.OperatingSystemConfig, memoryTopology, systemMemory string) {
+	memoryOneConfigurationInfo.DeletePartialMatch(oscLabels(osc))
+	memoryOneConfigurationInfo.WithLabelValues(osc.Namespace, osc.Name, memoryTopology, systemMemory).Set(1)
+}
+
+// recordInPlaceUpdateTargetVersion records the OS version to which the given OperatingSystemConfig requests an in-place
+// update. An empty version records that no in-place update is requested.
+func recordInPlaceUpdateTargetVersion(osc *extensionsv1alpha1.OperatingSystemConfig, version string) {
+	inPlaceUpdateTargetVersionInfo.DeletePartialMatch(oscLabels(osc))
+	if version != "" {
+		inPlaceUpdateTargetVersionInfo.WithLabelValues(osc.Namespace, osc.Name, version).Set(1)
+	}
+}
+
+// forgetMetrics deletes the metrics of the given OperatingSystemConfig.
+func forgetMetrics(osc *extensionsv1alpha1.OperatingSystemConfig) {
+	for _, metric := range []*prometheus.GaugeVec{renderedFiles, renderedUnits, memoryOneConfigurationInfo, inPlaceUpdateTargetVersionInfo} {
+		metric.DeletePartialMatch(oscLabels(osc))
+	}
+}
+
+func oscLabels(osc *extensionsv1alpha1.OperatingSystemConfig) prometheus.Labels {
+	return prometheus.Labels{"namespace": osc.Namespace, "name": osc.Name}
+}
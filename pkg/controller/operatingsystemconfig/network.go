@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"path/filepath"
+	"strconv"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/internal/shellscript"
+)
+
+const (
+	networkReadinessScriptName = "wait-for-network.sh"
+
+	defaultNetworkReadinessTimeout       = 5 * time.Minute
+	defaultNetworkReadinessRetryInterval = 5 * time.Second
+)
+
+var (
+	scriptContentNetworkReadiness []byte
+
+	networkReadinessScriptPath = filepath.Join(gardenlinux.ScriptLocation, networkReadinessScriptName)
+)
+
+func init() {
+	var err error
+
+	scriptContentNetworkReadiness, err = gardenlinux.Templates.ReadFile(filepath.Join("scripts", networkReadinessScriptName))
+	utilruntime.Must(err)
+}
+
+// networkReadiness returns the script which waits until the network is ready and the step which runs it with the given
+// checks. The provisioning fails if the checks do not succeed until the timeout expires.
+func networkReadiness(readiness *apisgardenlinux.NetworkReadiness) (provisionFile, *shellscript.Script) {
+	if readiness == nil {
+		readiness = &apisgardenlinux.NetworkReadiness{}
+	}
+
+	var (
+		timeout       = defaultNetworkReadinessTimeout
+		retryInterval = defaultNetworkReadinessRetryInterval
+	)
+	if readiness.Timeout != nil {
+		timeout = readiness.Timeout.Duration
+	}
+	if readiness.RetryInterval != nil {
+		retryInterval = readiness.RetryInterval.Duration
+	}
+
+	args := []string{
+		"--timeout", strconv.FormatInt(int64(timeout.Round(time.Second)/time.Second), 10),
+		"--retry-interval", strconv.FormatInt(int64(max(retryInterval.Round(time.Second), time.Second)/time.Second), 10),
+	}
+	if ptr.Deref(readiness.NetworkOnline, true) {
+		args = append(args, "--network-online")
+	}
+	if ptr.Deref(readiness.ResolveHostname, false) {
+		args = append(args, "--resolve-hostname")
+	}
+	for _, endpoint := range readiness.Endpoints {
+		args = append(args, "--endpoint", endpoint)
+	}
+
+	return provisionFile{
+		path:        networkReadinessScriptPath,
+		content:     scriptContentNetworkReadiness,
+		permissions: &gardenlinux.ScriptPermissions,
+	}, shellscript.New().RunOrExit(networkReadinessScriptPath, args...)
+}
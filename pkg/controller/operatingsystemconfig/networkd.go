@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/ptr"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+)
+
+const (
+	networkdConfigDir = "/etc/systemd/network"
+
+	unitNameNetworkd = "systemd-networkd.service"
+)
+
+// networkd returns the tuning which configures the given network interfaces with systemd-networkd. The files are
+// prefixed with a low number, so that they take precedence over the default configuration of the machine image.
+func networkd(network *apisgardenlinux.Network) tuning {
+	var t tuning
+
+	if network == nil || len(network.Interfaces) == 0 {
+		return t
+	}
+
+	var filePaths []string
+	for _, iface := range network.Interfaces {
+		filePath := path.Join(networkdConfigDir, "10-gardener-"+iface.Name+".network")
+		filePaths = append(filePaths, filePath)
+		t.files = append(t.files, inlineFile(filePath, networkdConfig(iface)))
+	}
+
+	t.units = append(t.units, extensionsv1alpha1.Unit{
+		Name:      unitNameNetworkd,
+		FilePaths: filePaths,
+	})
+	t.provision.Command("networkctl reload")
+
+	return t
+}
+
+// networkdConfig renders the `.network` file of the given network interface configuration.
+func networkdConfig(iface apisgardenlinux.NetworkInterface) string {
+	var out strings.Builder
+
+	out.WriteString("[Match]\n")
+	writeNetworkdList(&out, "Name", iface.Match.Names)
+	writeNetworkdList(&out, "MACAddress", iface.Match.MACAddresses)
+	writeNetworkdList(&out, "Driver", iface.Match.Drivers)
+
+	if iface.MTU != nil {
+		fmt.Fprintf(&out, "\n[Link]\nMTUBytes=%d\n", *iface.MTU)
+	}
+
+	dhcp := ptr.Deref(iface.DHCP, apisgardenlinux.DHCP{})
+	mode := ptr.Deref(dhcp.Mode, apisgardenlinux.DHCPModeYes)
+
+	fmt.Fprintf(&out, "\n[Network]\nDHCP=%s\n", mode)
+	for _, address := range iface.Addresses {
+		fmt.Fprintf(&out, "Address=%s\n", address)
+	}
+
+	if mode == apisgardenlinux.DHCPModeYes || mode == apisgardenlinux.DHCPModeIPv4 {
+		if dhcp.UseDNS != nil || dhcp.UseMTU != nil || dhcp.RouteMetric != nil {
+			out.WriteString("\n[DHCPv4]\n")
+			writeNetworkdBool(&out, "UseDNS", dhcp.UseDNS)
+			writeNetworkdBool(&out, "UseMTU", dhcp.UseMTU)
+			if dhcp.RouteMetric != nil {
+				fmt.Fprintf(&out, "RouteMetric=%d\n", *dhcp.RouteMetric)
+			}
+		}
+	}
+
+	if mode == apisgardenlinux.DHCPModeYes || mode == apisgardenlinux.DHCPModeIPv6 {
+		if dhcp.UseDNS != nil {
+			out.WriteString("\n[DHCPv6]\n")
+			writeNetworkdBool(&out, "UseDNS", dhcp.UseDNS)
+		}
+	}
+
+	for _, route := range iface.Routes {
+		fmt.Fprintf(&out, "\n[Route]\nDestination=%s\n", route.Destination)
+		if route.Gateway != nil {
+			fmt.Fprintf(&out, "Gateway=%s\n", *route.Gateway)
+		}
+		if route.Metric != nil {
+			fmt.Fprintf(&out, "Metric=%d\n", *route.Metric)
+		}
+	}
+
+	return out.String()
+}
+
+func writeNetworkdList(out *strings.Builder, key string, values []string) {
+	if len(values) > 0 {
+		fmt.Fprintf(out, "%s=%s\n", key, strings.Join(values, " "))
+	}
+}
+
+func writeNetworkdBool(out *strings.Builder, key string, value *bool) {
+	if value != nil {
+		fmt.Fprintf(out, "%s=%t\n", key, *value)
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/ptr"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+)
+
+const (
+	timesyncdConfigFilePath = "/etc/systemd/timesyncd.conf.d/gardener.conf"
+	chronyConfigFilePath    = "/etc/chrony/gardener.conf"
+
+	unitNameTimesyncd = "systemd-timesyncd.service"
+	unitNameChrony    = "chrony.service"
+
+	chronyExecDropInName    = "10-gardener-config.conf"
+	chronyExecDropInContent = `[Service]
+ExecStart=
+ExecStart=/usr/sbin/chronyd -f ` + chronyConfigFilePath + `
+`
+)
+
+// ntp returns the tuning which synchronises the time with the given NTP servers. The configured daemon is enabled and
+// restarted whenever its configuration changes, the other daemon is disabled.
+func ntp(config *apisgardenlinux.NTP) tuning {
+	var t tuning
+
+	if config == nil || len(config.Servers) == 0 {
+		return t
+	}
+
+	switch ptr.Deref(config.Daemon, apisgardenlinux.NTPDaemonTimesyncd) {
+	case apisgardenlinux.NTPDaemonChrony:
+		var content strings.Builder
+		for _, server := range config.Servers {
+			content.WriteString("server " + server + " iburst\n")
+		}
+		content.WriteString(`driftfile /var/lib/chrony/chrony.drift
+makestep 1.0 3
+rtcsync
+`)
+
+		t.files = append(t.files, inlineFile(chronyConfigFilePath, content.String()))
+		t.units = append(t.units,
+			extensionsv1alpha1.Unit{
+				Name:      unitNameChrony,
+				Enable:    ptr.To(true),
+				Command:   ptr.To(extensionsv1alpha1.CommandRestart),
+				DropIns:   []extensionsv1alpha1.DropIn{{Name: chronyExecDropInName, Content: chronyExecDropInContent}},
+				FilePaths: []string{chronyConfigFilePath},
+			},
+			extensionsv1alpha1.Unit{
+				Name:   unitNameTimesyncd,
+				Enable: ptr.To(false),
+			},
+		)
+
+	default:
+		t.files = append(t.files, inlineFile(timesyncdConfigFilePath, "[Time]\nNTP="+strings.Join(config.Servers, " ")+"\n"))
+		t.units = append(t.units, extensionsv1alpha1.Unit{
+			Name:      unitNameTimesyncd,
+			Enable:    ptr.To(true),
+			Command:   ptr.To(extensionsv1alpha1.CommandRestart),
+			FilePaths: []string{timesyncdConfigFilePath},
+		})
+	}
+
+	return t
+}
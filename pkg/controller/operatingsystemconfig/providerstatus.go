@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardenlinuxv1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/v1alpha1"
+)
+
+// updateProviderStatus publishes the given status in the `status.providerStatus` of the given OperatingSystemConfig. An
+// OperatingSystemConfig which does not exist anymore is not updated.
+func (a *actuator) updateProviderStatus(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, status *gardenlinuxv1alpha1.OperatingSystemConfigurationStatus) error {
+	status.TypeMeta = metav1.TypeMeta{
+		APIVersion: gardenlinuxv1alpha1.SchemeGroupVersion.String(),
+		Kind:       "OperatingSystemConfigurationStatus",
+	}
+
+	patch := client.MergeFrom(osc.DeepCopy())
+	osc.Status.ProviderStatus = &runtime.RawExtension{Object: status}
+	if err := a.client.Status().Patch(ctx, osc, patch); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to update provider status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"path/filepath"
+	"strings"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/features"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/internal/shellscript"
+)
+
+const (
+	reportStepFiles      = "files"
+	reportStepNetwork    = "network"
+	reportStepContainerd = "containerd"
+	reportStepUnits      = "units"
+)
+
+// provisionReportFunctions are the shell functions which report the progress of the provisioning. They are inlined
+// into the provisioning script without comments and empty lines to keep the user data small.
+var provisionReportFunctions string
+
+func init() {
+	content, err := gardenlinux.Templates.ReadFile(filepath.Join("scripts", "provision-report.sh"))
+	utilruntime.Must(err)
+
+	var lines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			lines = append(lines, line)
+		}
+	}
+	provisionReportFunctions = strings.Join(lines, "\n")
+}
+
+// reportFunctions returns a script with the functions which report the progress of the provisioning. It is empty if
+// the ProvisionReport feature gate is disabled.
+func reportFunctions() *shellscript.Script {
+	script := shellscript.New()
+	if features.FeatureGate.Enabled(features.ProvisionReport) {
+		script.Command(provisionReportFunctions)
+	}
+	return script
+}
+
+// reportStep appends the given steps to the script enclosed by commands which report the start and the end of the step
+// with the given name. Nothing is appended if there are no steps, the steps are appended without being reported if the
+// ProvisionReport feature gate is disabled.
+func reportStep(script *shellscript.Script, name string, steps *shellscript.Script) {
+	if len(steps.Steps()) == 0 {
+		return
+	}
+
+	if !features.FeatureGate.Enabled(features.ProvisionReport) {
+		script.Append(steps)
+		return
+	}
+
+	script.
+		Command("provision_step_begin " + shellscript.Quote(name)).
+		Append(steps).
+		Command("provision_step_end $?")
+}
+
+// reportedCommands returns the commands of the provisioning preceded by the functions which report its progress.
+func (d *provisionData) reportedCommands() *shellscript.Script {
+	return reportFunctions().Append(d.preHooks).Append(d.commands)
+}
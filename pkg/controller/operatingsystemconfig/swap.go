@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"path/filepath"
+	"strconv"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
+)
+
+const (
+	swapScriptName = "setup-swap.sh"
+
+	unitNameSwap = "gardener-swap.service"
+
+	defaultZramCompressionAlgorithm = "zstd"
+	defaultSwapFilePath             = "/var/lib/swapfile"
+
+	mebibyte = 1 << 20
+)
+
+var (
+	scriptContentSwap []byte
+
+	swapScriptPath = filepath.Join(gardenlinux.ScriptLocation, swapScriptName)
+)
+
+func init() {
+	var err error
+
+	scriptContentSwap, err = gardenlinux.Templates.ReadFile(filepath.Join("scripts", swapScriptName))
+	utilruntime.Must(err)
+}
+
+// swap returns the tuning which sets up the given swap space. The unit sets up the swap space before the kubelet is
+// started, its command line contains the configuration, so that gardener-node-agent restarts it whenever the
+// configuration changes. Sizes are rounded up to full mebibytes.
+func swap(config *apisgardenlinux.Swap) tuning {
+	var t tuning
+
+	if config == nil || (config.Zram == nil && config.File == nil) {
+		return t
+	}
+
+	var args, device string
+	if zram := config.Zram; zram != nil {
+		device = "/dev/zram0"
+		args = "zram " + swapSize(zram.Size.Value()) + " " + ptr.Deref(zram.CompressionAlgorithm, defaultZramCompressionAlgorithm)
+	} else {
+		device = ptr.Deref(config.File.Path, defaultSwapFilePath)
+		args = "file " + device + " " + swapSize(config.File.Size.Value())
+	}
+
+	t.files = append(t.files, extensionsv1alpha1.File{
+		Path: swapScriptPath,
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data:     utils.EncodeBase64(scriptContentSwap),
+				Encoding: "b64",
+			},
+		},
+		Permissions: &gardenlinux.ScriptPermissions,
+	})
+	t.units = append(t.units, extensionsv1alpha1.Unit{
+		Name:    unitNameSwap,
+		Enable:  ptr.To(true),
+		Command: ptr.To(extensionsv1alpha1.CommandRestart),
+		Content: ptr.To(`[Unit]
+Description=Set up swap space for the kubelet
+DefaultDependencies=no
+After=local-fs.target systemd-modules-load.service
+Before=swap.target kubelet.service
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=` + swapScriptPath + ` ` + args + `
+ExecStop=/usr/sbin/swapoff ` + device + `
+[Install]
+WantedBy=swap.target multi-user.target
+`),
+		FilePaths: []string{swapScriptPath},
+	})
+
+	return t
+}
+
+// swapSize returns the given size in bytes rounded up to full mebibytes.
+func swapSize(size int64) string {
+	return strconv.FormatInt((size+mebibyte-1)/mebibyte*mebibyte, 10)
+}
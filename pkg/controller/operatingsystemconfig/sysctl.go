@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+)
+
+const (
+	sysctlFilePath = "/etc/sysctl.d/99-gardener.conf"
+
+	unitNameSysctl = "systemd-sysctl.service"
+)
+
+// sysctlProfiles contains the kernel parameters of the built-in sysctl profiles.
+var sysctlProfiles = map[apisgardenlinux.SysctlProfile]map[string]string{
+	apisgardenlinux.SysctlProfileHighConnectionCount: {
+		"fs.file-max":                        "2097152",
+		"net.core.netdev_max_backlog":        "16384",
+		"net.core.somaxconn":                 "32768",
+		"net.ipv4.ip_local_port_range":       "1024 65535",
+		"net.ipv4.tcp_fin_timeout":           "15",
+		"net.ipv4.tcp_max_syn_backlog":       "8096",
+		"net.ipv4.tcp_tw_reuse":              "1",
+		"net.netfilter.nf_conntrack_max":     "1048576",
+		"net.netfilter.nf_conntrack_buckets": "262144",
+	},
+	apisgardenlinux.SysctlProfileElasticsearch: {
+		"vm.max_map_count": "262144",
+		"vm.swappiness":    "1",
+	},
+}
+
+// sysctls returns the tuning which sets the given default kernel parameters and the kernel parameters of the given
+// configuration, which take precedence.
+func sysctls(defaults map[string]string, config *apisgardenlinux.Sysctls) tuning {
+	var t tuning
+
+	parameters := maps.Clone(defaults)
+	if parameters == nil {
+		parameters = make(map[string]string)
+	}
+	if config != nil {
+		for _, profile := range config.Profiles {
+			maps.Copy(parameters, sysctlProfiles[profile])
+		}
+		maps.Copy(parameters, config.Parameters)
+	}
+
+	if len(parameters) == 0 {
+		return t
+	}
+
+	var content strings.Builder
+	for _, key := range slices.Sorted(maps.Keys(parameters)) {
+		fmt.Fprintf(&content, "%s = %s\n", key, parameters[key])
+	}
+
+	t.files = append(t.files, inlineFile(sysctlFilePath, content.String()))
+	t.units = append(t.units, extensionsv1alpha1.Unit{
+		Name:      unitNameSysctl,
+		FilePaths: []string{sysctlFilePath},
+	})
+	t.provision.Command("sysctl --system")
+
+	return t
+}
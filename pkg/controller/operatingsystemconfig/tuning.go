@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/internal/shellscript"
+)
+
+// tuning contains the files and units which apply a node tuning. The units depend on the files, so that
+// gardener-node-agent restarts them whenever the files change. Units which only depend on files are provided by the
+// machine image, the provision steps apply the tuning with them once the files have been written during provisioning.
+// Units with content, drop-ins or an explicit enablement or command are written and started like the units of the
+// OperatingSystemConfig during provisioning, unless the provision steps already enable and start them.
+type tuning struct {
+	files        []extensionsv1alpha1.File
+	units        []extensionsv1alpha1.Unit
+	provision    shellscript.Script
+	startedUnits sets.Set[string]
+}
+
+// add adds the given tuning, its provision steps are reported as step with the given name.
+func (t *tuning) add(name string, other tuning) {
+	t.files = append(t.files, other.files...)
+	t.units = append(t.units, other.units...)
+	reportStep(&t.provision, name, &other.provision)
+	t.startedUnits = t.startedUnits.Union(other.startedUnits)
+}
+
+// nodeTuning returns the node tuning of the given configuration. The default kernel modules of the extension are
+// loaded unless the configuration specifies kernel modules, its sysctls take precedence over the default sysctls.
+func (a *actuator) nodeTuning(config *apisgardenlinux.OperatingSystemConfiguration) tuning {
+	var t tuning
+
+	modules := config.KernelModules
+	if modules == nil {
+		modules = &apisgardenlinux.KernelModules{Load: a.defaultKernelModules}
+	}
+
+	t.add("kernel-modules", kernelModules(modules))
+	t.add("sysctls", sysctls(a.defaultSysctls, config.Sysctls))
+	t.add("network-configuration", networkd(config.Network))
+	t.add("swap", swap(config.Swap))
+	t.add("ntp", ntp(config.NTP))
+	t.add("local-storage", localStorage(config.LocalStorage))
+
+	return t
+}
+
+// tuning returns the node tuning of the given configuration including the parts which read referenced resources from
+// the namespace of the given OperatingSystemConfig.
+func (a *actuator) tuning(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, config *apisgardenlinux.OperatingSystemConfiguration) (tuning, error) {
+	t := a.nodeTuning(config)
+
+	caCertificates, err := a.caCertificates(ctx, osc.Namespace, config.CABundles)
+	if err != nil {
+		return t, err
+	}
+	t.add("ca-certificates", caCertificates)
+
+	return t, nil
+}
+
+// inlineFile returns a file with the given path and base64 encoded content which is readable by everyone.
+func inlineFile(path, content string) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path: path,
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data:     utils.EncodeBase64([]byte(content)),
+				Encoding: "b64",
+			},
+		},
+		Permissions: ptr.To(uint32(0644)),
+	}
+}
+
+// provisionUnits returns the units of the tuning which are written and started during provisioning.
+func (t *tuning) provisionUnits() []extensionsv1alpha1.Unit {
+	var units []extensionsv1alpha1.Unit
+	for _, unit := range t.units {
+		if unit.Content != nil || len(unit.DropIns) > 0 || unit.Enable != nil || unit.Command != nil {
+			units = append(units, unit)
+		}
+	}
+	return units
+}
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/config"
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/internal/shellscript"
+)
+
+const (
+	unitNameContainerd = "containerd.service"
+
+	containerdExecConfigDropInPath = "/etc/systemd/system/containerd.service.d/11-exec_config.conf"
+	containerdLimitsDropInName     = "override.conf"
+
+	daemonReloadCommand     = "systemctl daemon-reload"
+	provisionAppliedCommand = "mkdir -p /var/lib/osc && touch /var/lib/osc/provision-osc-applied"
+
+	cloudConfigHeader = "#cloud-config\n"
+
+	contentTypeShellScript = "text/x-shellscript"
+	contentTypeCloudConfig = "text/cloud-config"
+	mimeBoundary           = "==BOUNDARY=="
+)
+
+// mimePart is a part of a MIME multipart user data document.
+type mimePart struct {
+	contentType      string
+	transferEncoding string
+	content          string
+}
+
+// mimeMultipart renders the given parts into a MIME multipart document.
+func mimeMultipart(parts ...mimePart) string {
+	var out strings.Builder
+
+	out.WriteString(`Content-Type: multipart/mixed; boundary="` + mimeBoundary + `"
+MIME-Version: 1.0
+--` + mimeBoundary + `
+`)
+
+	for _, part := range parts {
+		out.WriteString(`Content-Type: ` + part.contentType + `
+`)
+		if part.transferEncoding != "" {
+			out.WriteString(`Content-Transfer-Encoding: ` + part.transferEncoding + `
+`)
+		}
+		out.WriteString(`
+` + part.content + `
+--` + mimeBoundary + `
+`)
+	}
+
+	return out.String()
+}
+
+// provisionScript renders the bash script which provisions the node. The scripts of the pre hooks are written and run
+// before any other file is written.
+func (d *provisionData) provisionScript() string {
+	preHooks := shellscript.New()
+	for _, file := range d.preHookFiles {
+		preHooks.WriteFile(file.path, file.content, file.permissions)
+	}
+	preHooks.Append(d.preHooks)
+
+	writeFiles := shellscript.New()
+	for _, file := range slices.Concat(d.files, d.unitFiles()) {
+		writeFiles.WriteFile(file.path, file.content, file.permissions)
+	}
+
+	script := reportFunctions().Append(preHooks)
+	reportStep(script, reportStepFiles, writeFiles)
+	script.Append(d.commands)
+
+	// The provisioning script must run only once.
+	return operatingsystemconfig.WrapProvisionOSCIntoOneshotScript(script.String())
+}
+
+// provisionData contains the files and units which are written and the commands which are run when provisioning a
+// node. It is the format independent representation of the provisioning script.
+type provisionData struct {
+	// preHookFiles are the scripts of the pre hooks, preHooks are the commands which run them before the node is
+	// configured.
+	preHookFiles []provisionFile
+	preHooks     *shellscript.Script
+
+	files    []provisionFile
+	units    []extensionsv1alpha1.Unit
+	commands *shellscript.Script
+}
+
+// allFiles returns the scripts of the pre hooks followed by all other files which are written, without the units.
+func (d *provisionData) allFiles() []provisionFile {
+	return slices.Concat(d.preHookFiles, d.files)
+}
+
+// provisionFile is a file with resolved content which is written when provisioning a node.
+type provisionFile struct {
+	path        string
+	content     []byte
+	permissions *uint32
+}
+
+// provisionData returns the files, units and commands which provision the node in the same order as the provisioning
+// script. The containerd configuration is rendered in the layout of the given major version of containerd. The units
+// are only started once the network is ready. The matching provisioning hooks run before any file of the node is
+// written and after the units are started, respectively.
+func (a *actuator) provisionData(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, osConfig *apisgardenlinux.OperatingSystemConfiguration, containerdMajorVersion int32) (*provisionData, error) {
+	tunings, err := a.tuning(ctx, osc, osConfig)
+	if err != nil {
+		return nil, err
+	}
+	units := slices.Concat(osc.Spec.Units, tunings.provisionUnits())
+
+	hooks, err := a.provisionHooks(ctx, osc)
+	if err != nil {
+		return nil, err
+	}
+	preHookFiles, preHooks := provisionHookSteps(hooks, config.ProvisionHookPointPre)
+	postHookFiles, postHooks := provisionHookSteps(hooks, config.ProvisionHookPointPost)
+
+	generation, err := containerdGenerationFor(containerdMajorVersion)
+	if err != nil {
+		return nil, v1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	data := &provisionData{
+		preHookFiles: preHookFiles,
+		preHooks:     preHooks,
+		units: append([]extensionsv1alpha1.Unit{{
+			Name:    unitNameContainerd,
+			DropIns: []extensionsv1alpha1.DropIn{{Name: path.Base(containerdExecConfigDropInPath), Content: generation.execConfigDropIn}},
+		}}, units...),
+	}
+
+	for _, file := range slices.Concat(osc.Spec.Files, tunings.files) {
+		content, err := a.fileContent(ctx, osc.Namespace, &file.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		data.files = append(data.files, provisionFile{
+			path:        file.Path,
+			content:     content,
+			permissions: file.Permissions,
+		})
+	}
+
+	containerdFiles, err := containerdFiles(osc.Spec.CRIConfig, containerdMajorVersion)
+	if err != nil {
+		return nil, v1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	data.files = append(data.files, containerdFiles...)
+
+	networkReadinessScript, waitForNetwork := networkReadiness(osConfig.NetworkReadiness)
+	data.files = append(data.files, networkReadinessScript)
+	data.files = append(data.files, postHookFiles...)
+
+	unitCommands := shellscript.New()
+	for _, unit := range units {
+		if !tunings.startedUnits.Has(unit.Name) {
+			addUnitCommands(unitCommands, unit)
+		}
+	}
+
+	data.commands = shellscript.New().Append(&tunings.provision)
+	reportStep(data.commands, reportStepNetwork, waitForNetwork)
+	reportStep(data.commands, reportStepContainerd, shellscript.New().
+		Command(daemonReloadCommand).
+		EnableUnit(unitNameContainerd).
+		RestartUnit(unitNameContainerd))
+	reportStep(data.commands, reportStepUnits, unitCommands)
+	data.commands.Append(postHooks)
+
+	return data, nil
+}
+
+// addUnitCommands adds the commands for the given unit like gardener-node-agent runs them on reconcile: The unit is
+// enabled unless it is explicitly not enabled, in which case it is disabled. It is stopped if it is not enabled or its
+// command is `stop`, otherwise it is restarted.
+func addUnitCommands(script *shellscript.Script, unit extensionsv1alpha1.Unit) {
+	enable := ptr.Deref(unit.Enable, true)
+
+	if enable {
+		script.EnableUnit(unit.Name)
+	} else {
+		script.DisableUnit(unit.Name)
+	}
+
+	if !enable || ptr.Deref(unit.Command, "") == extensionsv1alpha1.CommandStop {
+		script.StopUnitNoBlock(unit.Name)
+	} else {
+		script.RestartUnitNoBlock(unit.Name)
+	}
+}
+
+// unitFiles returns the units and their drop-ins as files which are readable by everyone.
+func (d *provisionData) unitFiles() []provisionFile {
+	var (
+		files       []provisionFile
+		permissions = uint32(0644)
+	)
+
+	for _, unit := range d.units {
+		unitFilePath := path.Join("/", "etc", "systemd", "system", unit.Name)
+
+		if unit.Content != nil {
+			files = append(files, provisionFile{path: unitFilePath, content: []byte(*unit.Content), permissions: &permissions})
+		}
+
+		for _, dropIn := range unit.DropIns {
+			files = append(files, provisionFile{path: path.Join(unitFilePath+".d", dropIn.Name), content: []byte(dropIn.Content), permissions: &permissions})
+		}
+	}
+
+	return files
+}
+
+// fileContent returns the decoded content of the given file content. Secret references are read from the given
+// namespace.
+func (a *actuator) fileContent(ctx context.Context, namespace string, content *extensionsv1alpha1.FileContent) ([]byte, error) {
+	if inline := content.Inline; inline != nil {
+		return extensionsv1alpha1helper.Decode(inline.Encoding, []byte(inline.Data))
+	}
+
+	secret := &corev1.Secret{}
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: content.SecretRef.Name}, secret); err != nil {
+		return nil, err
+	}
+
+	return secret.Data[content.SecretRef.DataKey], nil
+}
+
+// script renders the commands into a bash script which runs only once.
+func (d *provisionData) script() string {
+	return operatingsystemconfig.WrapProvisionOSCIntoOneshotScript(d.reportedCommands().String())
+}
+
+// cloudConfig is the subset of the cloud-init `#cloud-config` document which is used to provision the nodes.
+type cloudConfig struct {
+	WriteFiles []cloudConfigFile `json:"write_files,omitempty"`
+	RunCmd     []string          `json:"runcmd,omitempty"`
+}
+
+// cloudConfigFile is an entry of the `write_files` module of cloud-init.
+type cloudConfigFile struct {
+	Path        string `json:"path"`
+	Content     string `json:"content"`
+	Encoding    string `json:"encoding,omitempty"`
+	Permissions string `json:"permissions,omitempty"`
+}
+
+// cloudConfig renders the files and, if requested, the commands into a cloud-init `#cloud-config` document. The
+// commands are followed by a command which marks the provisioning as applied like the provisioning script does.
+func (d *provisionData) cloudConfig(withCommands bool) (string, error) {
+	var config cloudConfig
+
+	for _, file := range slices.Concat(d.allFiles(), d.unitFiles()) {
+		f := cloudConfigFile{Path: file.path, Content: string(file.content)}
+		if !utf8.Valid(file.content) {
+			f.Content, f.Encoding = utils.EncodeBase64(file.content), "b64"
+		}
+		if file.permissions != nil {
+			f.Permissions = fmt.Sprintf("%04o", *file.permissions)
+		}
+		config.WriteFiles = append(config.WriteFiles, f)
+	}
+
+	if withCommands {
+		config.RunCmd = append(slices.Clone(d.reportedCommands().Steps()), provisionAppliedCommand)
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to render cloud-config: %w", err)
+	}
+
+	return cloudConfigHeader + string(out), nil
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"bytes"
+	"cmp"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	contentTypeGzip = "application/x-gzip"
+
+	// maxUserDataContributors is the maximum number of contributors which are named when the user data exceeds its
+	// size limit.
+	maxUserDataContributors = 5
+)
+
+// userDataSizeLimit returns the provider type of the shoot the given OperatingSystemConfig belongs to and the
+// configured size limit of its user data. A limit of zero means that the size of the user data is not limited.
+func (a *actuator) userDataSizeLimit(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) (string, int, error) {
+	if len(a.userDataSizeLimits) == 0 {
+		return "", 0, nil
+	}
+
+	shoot, err := extensionscontroller.GetShoot(ctx, a.client, osc.Namespace)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read shoot from cluster: %w", err)
+	}
+	if shoot == nil {
+		return "", 0, nil
+	}
+
+	return shoot.Spec.Provider.Type, a.userDataSizeLimits[shoot.Spec.Provider.Type], nil
+}
+
+// userDataSizeError returns a configuration error which names the largest files and units of the user data.
+func (a *actuator) userDataSizeError(data *provisionData, providerType string, size, limit int) error {
+	type contributor struct {
+		name string
+		size int
+	}
+
+	var contributors []contributor
+	for _, file := range data.allFiles() {
+		contributors = append(contributors, contributor{name: fmt.Sprintf("file %q", file.path), size: len(file.content)})
+	}
+	for _, unit := range data.units {
+		unitSize := len(ptr.Deref(unit.Content, ""))
+		for _, dropIn := range unit.DropIns {
+			unitSize += len(dropIn.Content)
+		}
+		contributors = append(contributors, contributor{name: fmt.Sprintf("unit %q", unit.Name), size: unitSize})
+	}
+
+	slices.SortStableFunc(contributors, func(a, b contributor) int { return cmp.Compare(b.size, a.size) })
+
+	var largest []string
+	for _, c := range contributors[:min(len(contributors), maxUserDataContributors)] {
+		largest = append(largest, fmt.Sprintf("%s (%d bytes)", c.name, c.size))
+	}
+
+	msg := fmt.Sprintf("user data has %d bytes and exceeds the limit of %d bytes for provider type %q, largest contributors: %s", size, limit, providerType, strings.Join(largest, ", "))
+	if !a.compressUserData {
+		msg += ", consider enabling the compression of the user data"
+	}
+
+	return v1beta1helper.NewErrorWithCodes(errors.New(msg), gardencorev1beta1.ErrorConfigurationProblem)
+}
+
+// compressParts compresses the given parts of the user data. Shell scripts are replaced by a self-extracting script,
+// all other parts by a gzip part which cloud-init decompresses.
+func compressParts(parts []mimePart) ([]mimePart, error) {
+	compressed := make([]mimePart, 0, len(parts))
+
+	for _, part := range parts {
+		if part.contentType == contentTypeShellScript {
+			script, err := selfExtractingScript(part.content)
+			if err != nil {
+				return nil, err
+			}
+			compressed = append(compressed, mimePart{contentType: contentTypeShellScript, content: script})
+			continue
+		}
+
+		data, err := gzipData([]byte(part.content))
+		if err != nil {
+			return nil, err
+		}
+		compressed = append(compressed, mimePart{contentType: contentTypeGzip, transferEncoding: "base64", content: utils.EncodeBase64(data)})
+	}
+
+	return compressed, nil
+}
+
+// selfExtractingScript returns a small bash script which extracts and runs the given compressed script.
+func selfExtractingScript(script string) (string, error) {
+	data, err := gzipData([]byte(script))
+	if err != nil {
+		return "", err
+	}
+
+	return `#!/bin/bash
+set -o errexit
+set -o pipefail
+
+script="$(mktemp)"
+trap 'rm -f "$script"' EXIT
+
+base64 -d << 'EOF' | gzip -d > "$script"
+` + utils.EncodeBase64(data) + `
+EOF
+/bin/bash "$script"
+`, nil
+}
+
+// gzipData compresses the given data with gzip.
+func gzipData(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to compress user data: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress user data: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"regexp"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const maxUnitNameLength = 255
+
+var (
+	// unitNameRegex matches the names of systemd units including templates and instances, see systemd.unit(5).
+	unitNameRegex   = regexp.MustCompile(`^[a-zA-Z0-9:_.\\-]+(@[a-zA-Z0-9:_.\\-]*)?\.(service|socket|device|mount|automount|swap|target|path|timer|slice|scope)$`)
+	dropInNameRegex = regexp.MustCompile(`^[a-zA-Z0-9:_.\\@-]+\.conf$`)
+
+	supportedUnitCommands = sets.New(
+		string(extensionsv1alpha1.CommandStart),
+		string(extensionsv1alpha1.CommandRestart),
+		string(extensionsv1alpha1.CommandStop),
+	)
+)
+
+// validateUnits validates that the given units and their drop-ins have valid systemd unit names and that the units
+// have supported commands.
+func validateUnits(units []extensionsv1alpha1.Unit, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, unit := range units {
+		idxPath := fldPath.Index(i)
+
+		if len(unit.Name) > maxUnitNameLength || !unitNameRegex.MatchString(unit.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), unit.Name, "must be a valid systemd unit name"))
+		}
+
+		if unit.Command != nil && !supportedUnitCommands.Has(string(*unit.Command)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("command"), *unit.Command, sets.List(supportedUnitCommands)))
+		}
+
+		for j, dropIn := range unit.DropIns {
+			if len(dropIn.Name) > maxUnitNameLength || !dropInNameRegex.MatchString(dropIn.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("dropIns").Index(j).Child("name"), dropIn.Name, "must be a valid systemd drop-in name ending with .conf"))
+			}
+		}
+	}
+
+	return allErrs
+}
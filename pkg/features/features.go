@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package features
+
+import (
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// ProvisionReport enables the report of the progress and the timing of the provisioning steps on the nodes.
+	// beta: v0.33
+	ProvisionReport featuregate.Feature = "ProvisionReport"
+)
+
+var (
+	// FeatureGate is a shared global FeatureGate for the Garden Linux extension flags.
+	FeatureGate = featuregate.NewFeatureGate()
+
+	// AllFeatureGates are all feature gates of the Garden Linux extension.
+	AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+		ProvisionReport: {Default: true, PreRelease: featuregate.Beta},
+	}
+)
+
+func init() {
+	utilruntime.Must(FeatureGate.Add(AllFeatureGates))
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenlinux
+
+import (
+	"errors"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/install"
+	gardenlinuxv1alpha1 "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/v1alpha1"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux/validation"
+)
+
+var (
+	scheme  *runtime.Scheme
+	decoder runtime.Decoder
+)
+
+var (
+	// ErrDecodeProviderConfig is wrapped by errors which are returned if the provider config of an
+	// OperatingSystemConfig cannot be decoded.
+	ErrDecodeProviderConfig = errors.New("failed to decode provider config")
+	// ErrInvalidProviderConfig is wrapped by errors which are returned if the provider config of an
+	// OperatingSystemConfig is invalid.
+	ErrInvalidProviderConfig = errors.New("invalid provider config")
+)
+
+func init() {
+	scheme = runtime.NewScheme()
+	install.Install(scheme)
+	decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+}
+
+// Configuration decodes, defaults and validates the Garden Linux provider configuration of the given
+// OperatingSystemConfig. If the OperatingSystemConfig is not of type `gardenlinux` or does not carry a provider
+// configuration, the defaulted configuration is returned.
+func Configuration(osc *extensionsv1alpha1.OperatingSystemConfig) (*apisgardenlinux.OperatingSystemConfiguration, error) {
+	config := &apisgardenlinux.OperatingSystemConfiguration{}
+
+	if osc.Spec.Type != OSTypeGardenLinux || osc.Spec.ProviderConfig == nil {
+		versioned := &gardenlinuxv1alpha1.OperatingSystemConfiguration{}
+		scheme.Default(versioned)
+		if err := scheme.Convert(versioned, config, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert default provider config: %w", err)
+		}
+		return config, nil
+	}
+
+	if _, _, err := decoder.Decode(osc.Spec.ProviderConfig.Raw, nil, config); err != nil {
+		return nil, fmt.Errorf("%w: %+v", ErrDecodeProviderConfig, err)
+	}
+
+	if errs := validation.ValidateOperatingSystemConfiguration(config, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProviderConfig, errs.ToAggregate())
+	}
+
+	return config, nil
+}
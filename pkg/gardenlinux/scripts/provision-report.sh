@@ -0,0 +1,47 @@
+# shellcheck shell=sh
+# Functions which report the progress of the provisioning. They are inlined into the provisioning script, which may be
+# run by a POSIX shell, e.g. as `runcmd` of cloud-init. The start and the end of every step are logged to the journal
+# and the serial console, a summary of all steps is written to /var/lib/osc/provision-report.json when the provisioning
+# script exits.
+provision_report_steps=
+provision_report_step=
+
+provision_report_log() {
+    logger --tag gardener-provision "$1" || true
+    echo "gardener-provision: $1" > /dev/console 2> /dev/null || true
+    echo "$1"
+}
+
+provision_report_time() {
+    date --utc +%Y-%m-%dT%H:%M:%S.%3NZ
+}
+
+# provision_step_begin <step> starts the given step.
+provision_step_begin() {
+    provision_report_step="$1"
+    provision_report_step_started_at="$(provision_report_time)"
+    provision_report_step_started_ms="$(date +%s%3N)"
+    provision_report_log "step $1 started"
+}
+
+# provision_step_end <exit-status> ends the current step with the given exit status.
+provision_step_end() {
+    provision_report_duration_ms=$(( $(date +%s%3N) - provision_report_step_started_ms ))
+    provision_report_log "step $provision_report_step finished with exit status $1 after ${provision_report_duration_ms}ms"
+    provision_report_steps="$provision_report_steps${provision_report_steps:+,}{\"step\":\"$provision_report_step\",\"startedAt\":\"$provision_report_step_started_at\",\"durationMilliseconds\":$provision_report_duration_ms,\"exitStatus\":$1}"
+    provision_report_step=
+}
+
+# provision_report_finish <exit-status> ends the current step, if the provisioning script exits during a step, and
+# writes the summary.
+provision_report_finish() {
+    if [ -n "$provision_report_step" ]; then
+        provision_step_end "$1"
+    fi
+    mkdir -p /var/lib/osc
+    printf '{"startedAt":"%s","finishedAt":"%s","exitStatus":%s,"steps":[%s]}\n' "$provision_report_started_at" "$(provision_report_time)" "$1" "$provision_report_steps" > /var/lib/osc/provision-report.json
+    provision_report_log "provisioning finished with exit status $1"
+}
+
+provision_report_started_at="$(provision_report_time)"
+trap 'provision_report_finish $?' EXIT
@@ -0,0 +1,106 @@
+#!/bin/bash
+
+set -euo pipefail
+
+if [ "$#" -ne 1 ]; then
+    echo "Usage: $0 <config-file>"
+    exit 2
+fi
+
+# The configuration file sets MOUNT_POINT, FILESYSTEM, MIN_SIZE and MAX_SIZE (in bytes, empty if not limited) as well
+# as the arrays MODELS and PATHS (shell-style globs) and DIRECTORIES (names of the subdirectories of the array which are
+# bind-mounted).
+# shellcheck source=/dev/null
+source "$1"
+
+array=/dev/md/gardener-local-storage
+label=gardener-md
+device="/dev/disk/by-label/$label"
+
+matches_any() {
+    local value="$1" pattern
+    shift
+
+    [ "$#" -eq 0 ] && return 0
+    for pattern in "$@"; do
+        # shellcheck disable=SC2053
+        [[ "$value" == $pattern ]] && return 0
+    done
+    return 1
+}
+
+select_disks() {
+    local name type size model
+
+    while read -r name type size; do
+        [ "$type" = "disk" ] || continue
+        # Disks with partitions, file systems in use or holders (e.g. other arrays) are never selected.
+        [ "$(lsblk --noheadings --list --output NAME "$name" | wc -l)" -eq 1 ] || continue
+        [ -z "$(lsblk --noheadings --nodeps --output MOUNTPOINT "$name")" ] || continue
+        [ -z "$(ls -A "/sys/class/block/$(basename "$name")/holders")" ] || continue
+
+        model="$(lsblk --noheadings --nodeps --output MODEL "$name" | sed 's/[[:space:]]*$//')"
+        matches_any "$name" "${PATHS[@]}" || continue
+        matches_any "$model" "${MODELS[@]}" || continue
+        [ -z "$MIN_SIZE" ] || [ "$size" -ge "$MIN_SIZE" ] || continue
+        [ -z "$MAX_SIZE" ] || [ "$size" -le "$MAX_SIZE" ] || continue
+
+        echo "$name"
+    done < <(lsblk --noheadings --nodeps --paths --bytes --output NAME,TYPE,SIZE)
+}
+
+if mountpoint --quiet "$MOUNT_POINT"; then
+    echo "local storage is already mounted at $MOUNT_POINT"
+    for directory in "${DIRECTORIES[@]}"; do
+        mkdir -p "$MOUNT_POINT/$directory"
+    done
+    exit 0
+fi
+
+# Moving the data of a running node to the local storage would hide it from the kubelet and containerd, hence the local
+# storage is only set up for new nodes.
+if systemctl is-active --quiet kubelet.service; then
+    echo "local storage can only be set up before the kubelet is started, replace the node to set it up"
+    exit 1
+fi
+
+# The array is found by the label of its file system, as its device name is only stable on the node which created it.
+if [ ! -e "$device" ]; then
+    mdadm --assemble --scan || true
+    udevadm settle
+fi
+
+if [ ! -e "$device" ]; then
+    if [ ! -e "$array" ]; then
+        mapfile -t disks < <(select_disks)
+        if [ "${#disks[@]}" -eq 0 ]; then
+            echo "no local disks match the selector"
+            exit 1
+        fi
+
+        echo "creating RAID0 array $array of ${disks[*]}"
+        wipefs --all "${disks[@]}"
+        mdadm --create "$array" --name=gardener-local-storage --level=0 --raid-devices="${#disks[@]}" --force --run "${disks[@]}"
+    fi
+
+    echo "creating $FILESYSTEM file system on $array"
+    case "$FILESYSTEM" in
+        xfs)
+            mkfs.xfs -f -L "$label" "$array"
+            ;;
+        *)
+            mkfs.ext4 -F -m 0 -L "$label" "$array"
+            ;;
+    esac
+    udevadm settle
+fi
+
+tmp="$(mktemp -d)"
+mount "$device" "$tmp"
+for directory in "${DIRECTORIES[@]}"; do
+    mkdir -p "$tmp/$directory"
+done
+umount "$tmp"
+rmdir "$tmp"
+
+echo "local storage is ready to be mounted at $MOUNT_POINT"
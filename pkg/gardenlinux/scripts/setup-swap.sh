@@ -0,0 +1,70 @@
+#!/bin/bash
+
+set -euo pipefail
+
+usage() {
+    echo "Usage: $0 zram <size-in-bytes> <compression-algorithm>"
+    echo "       $0 file <path> <size-in-bytes>"
+    exit 2
+}
+
+is_active() {
+    awk -v device="$1" '$1 == device { found = 1 } END { exit !found }' /proc/swaps
+}
+
+setup_zram() {
+    local size="$1" algorithm="$2" device=/dev/zram0
+
+    if is_active "$device"; then
+        echo "swap on $device is already active"
+        return
+    fi
+
+    modprobe zram num_devices=1
+    if [ "$(cat /sys/block/zram0/disksize)" != "0" ]; then
+        echo 1 > /sys/block/zram0/reset
+    fi
+    echo "$algorithm" > /sys/block/zram0/comp_algorithm
+    echo "$size" > /sys/block/zram0/disksize
+
+    mkswap "$device"
+    swapon --priority 100 "$device"
+    echo "activated swap on $device with $size bytes and compression algorithm $algorithm"
+}
+
+setup_file() {
+    local path="$1" size="$2"
+
+    if is_active "$path"; then
+        echo "swap file $path is already active"
+        return
+    fi
+
+    if [ ! -f "$path" ] || [ "$(stat -c %s "$path")" != "$size" ]; then
+        rm -f "$path"
+        mkdir -p "$(dirname "$path")"
+        # fallocate is not supported by all file systems, dd works everywhere.
+        if ! fallocate -l "$size" "$path"; then
+            dd if=/dev/zero of="$path" bs=1M count=$(( size / 1048576 )) status=none
+        fi
+        chmod 0600 "$path"
+        mkswap "$path"
+    fi
+
+    swapon "$path"
+    echo "activated swap file $path with $size bytes"
+}
+
+case "${1:-}" in
+    zram)
+        [ "$#" -eq 3 ] || usage
+        setup_zram "$2" "$3"
+        ;;
+    file)
+        [ "$#" -eq 3 ] || usage
+        setup_file "$2" "$3"
+        ;;
+    *)
+        usage
+        ;;
+esac
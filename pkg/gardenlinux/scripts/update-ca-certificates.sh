@@ -0,0 +1,20 @@
+#!/bin/bash
+
+set -euo pipefail
+
+bundle=/etc/ssl/certs/ca-certificates.crt
+
+checksum() {
+    sha256sum "$bundle" 2>/dev/null || true
+}
+
+before="$(checksum)"
+update-ca-certificates --fresh
+after="$(checksum)"
+
+# containerd reads the trust store only when it starts, hence it is restarted if the trust store changed while it was
+# running. The restart is not awaited, as containerd is ordered after this script.
+if [ "$before" != "$after" ] && systemctl is-active --quiet containerd.service; then
+    echo "trust store changed, restarting containerd"
+    systemctl restart --no-block containerd.service
+fi
@@ -0,0 +1,126 @@
+#!/bin/bash
+
+set -uo pipefail
+
+usage() {
+    echo "Usage: $0 --timeout <seconds> --retry-interval <seconds> [--network-online] [--resolve-hostname] [--endpoint <host:port>]..."
+    exit 2
+}
+
+timeout=""
+retry_interval=""
+network_online=false
+resolve_hostname=false
+endpoints=()
+
+while [ "$#" -gt 0 ]; do
+    case "$1" in
+        --timeout)
+            timeout="${2:-}"
+            shift 2 || usage
+            ;;
+        --retry-interval)
+            retry_interval="${2:-}"
+            shift 2 || usage
+            ;;
+        --network-online)
+            network_online=true
+            shift
+            ;;
+        --resolve-hostname)
+            resolve_hostname=true
+            shift
+            ;;
+        --endpoint)
+            endpoints+=("${2:-}")
+            shift 2 || usage
+            ;;
+        *)
+            usage
+            ;;
+    esac
+done
+
+if [[ ! "$timeout" =~ ^[0-9]+$ ]] || [[ ! "$retry_interval" =~ ^[0-9]+$ ]]; then
+    usage
+fi
+
+log() {
+    echo "network readiness: $*"
+    logger --tag gardener-network-readiness -- "$*" 2>/dev/null || true
+}
+
+restarted_networkd=false
+
+check_network_online() {
+    systemctl is-active --quiet network-online.target
+}
+
+check_hostname() {
+    if getent hosts "$(hostname)" >/dev/null; then
+        return 0
+    fi
+
+    # Restarting systemd-networkd once re-applies the DNS configuration received via DHCP.
+    if [ "$restarted_networkd" = false ]; then
+        log "hostname $(hostname) cannot be resolved, restarting systemd-networkd"
+        systemctl restart systemd-networkd || true
+        restarted_networkd=true
+    fi
+    return 1
+}
+
+# Without the hostname check, systemd-networkd is restarted once if the hostname cannot be resolved, as the provisioning
+# always did, but the provisioning does not wait for the hostname to become resolvable.
+restart_networkd_if_hostname_unresolvable() {
+    if ! getent hosts "$(hostname)" >/dev/null; then
+        log "hostname $(hostname) cannot be resolved, restarting systemd-networkd"
+        systemctl restart systemd-networkd || true
+    fi
+}
+
+check_endpoint() {
+    local host="${1%:*}"
+    local port="${1##*:}"
+
+    host="${host#[}"
+    host="${host%]}"
+    timeout 5 bash -c "exec 3<>/dev/tcp/\$0/\$1" "$host" "$port" 2>/dev/null
+}
+
+deadline=$(( $(date +%s) + timeout ))
+attempt=1
+
+while true; do
+    failed=()
+
+    if [ "$network_online" = true ] && ! check_network_online; then
+        failed+=("network-online.target is not active")
+    fi
+    if [ "$resolve_hostname" = true ] && ! check_hostname; then
+        failed+=("hostname $(hostname) cannot be resolved")
+    fi
+    for endpoint in "${endpoints[@]}"; do
+        if ! check_endpoint "$endpoint"; then
+            failed+=("endpoint $endpoint is not reachable")
+        fi
+    done
+
+    if [ "${#failed[@]}" -eq 0 ]; then
+        log "network is ready after $attempt attempt(s)"
+        if [ "$resolve_hostname" = false ]; then
+            restart_networkd_if_hostname_unresolvable
+        fi
+        exit 0
+    fi
+
+    log "attempt $attempt failed: $(IFS=';'; echo "${failed[*]}")"
+
+    if [ "$(( $(date +%s) + retry_interval ))" -gt "$deadline" ]; then
+        log "network is not ready after ${timeout}s, giving up: $(IFS=';'; echo "${failed[*]}")"
+        exit 1
+    fi
+
+    sleep "$retry_interval"
+    attempt=$(( attempt + 1 ))
+done
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package shellscript builds bash scripts from typed steps. All values passed to the steps are quoted, so that they
+// cannot break or inject into the generated script.
+package shellscript
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// Quote quotes the given string for POSIX shells. The string is enclosed in single quotes, single quotes inside of it
+// are closed, escaped and reopened.
+func Quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Script is a bash script built from typed steps.
+type Script struct {
+	steps []string
+}
+
+// New returns a new empty Script.
+func New() *Script {
+	return &Script{}
+}
+
+// Command adds the given command as is. It must only be used for constant commands which do not contain any input.
+func (s *Script) Command(command string) *Script {
+	s.steps = append(s.steps, command)
+	return s
+}
+
+// WriteFile adds a step which writes the given content to the file with the given path and, if given, sets its
+// permissions. The parent directory is created if it does not exist.
+func (s *Script) WriteFile(filePath string, content []byte, permissions *uint32) *Script {
+	step := `mkdir -p ` + Quote(path.Dir(filePath)) + `
+base64 -d > ` + Quote(filePath) + ` << 'EOF'
+` + utils.EncodeBase64(content) + `
+EOF`
+	if permissions != nil {
+		step += `
+chmod ` + fmt.Sprintf("%04o", *permissions) + ` ` + Quote(filePath)
+	}
+
+	s.steps = append(s.steps, step)
+	return s
+}
+
+// RunOrExit adds a step which runs the given executable with the given arguments and exits the script with an error if
+// it fails.
+func (s *Script) RunOrExit(executable string, args ...string) *Script {
+	command := Quote(executable)
+	for _, arg := range args {
+		command += " " + Quote(arg)
+	}
+	return s.Command(command + " || exit 1")
+}
+
+// EnableUnit adds a step which enables the given systemd unit.
+func (s *Script) EnableUnit(name string) *Script {
+	return s.Command("systemctl enable " + Quote(name))
+}
+
+// DisableUnit adds a step which disables the given systemd unit.
+func (s *Script) DisableUnit(name string) *Script {
+	return s.Command("systemctl disable " + Quote(name))
+}
+
+// RestartUnit adds a step which restarts the given systemd unit and waits until it is restarted.
+func (s *Script) RestartUnit(name string) *Script {
+	return s.Command("systemctl restart " + Quote(name))
+}
+
+// RestartUnitNoBlock adds a step which restarts the given systemd unit without waiting until it is restarted.
+func (s *Script) RestartUnitNoBlock(name string) *Script {
+	return s.Command("systemctl restart --no-block " + Quote(name))
+}
+
+// StopUnitNoBlock adds a step which stops the given systemd unit without waiting until it is stopped.
+func (s *Script) StopUnitNoBlock(name string) *Script {
+	return s.Command("systemctl stop --no-block " + Quote(name))
+}
+
+// LoadModule adds a step which loads the given kernel module.
+func (s *Script) LoadModule(name string) *Script {
+	return s.Command("modprobe " + Quote(name))
+}
+
+// Append adds the steps of the given script.
+func (s *Script) Append(other *Script) *Script {
+	s.steps = append(s.steps, other.steps...)
+	return s
+}
+
+// Steps returns the rendered steps of the script.
+func (s *Script) Steps() []string {
+	return s.steps
+}
+
+// String renders the script.
+func (s *Script) String() string {
+	return "#!/bin/bash\n" + strings.Join(s.steps, "\n") + "\n"
+}
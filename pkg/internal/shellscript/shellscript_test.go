@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shellscript_test
+
+import (
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-os-gardenlinux/pkg/internal/shellscript"
+)
+
+var _ = Describe("ShellScript", func() {
+	Describe("#Quote", func() {
+		DescribeTable("should quote the string",
+			func(s, expected string) {
+				Expect(Quote(s)).To(Equal(expected))
+			},
+
+			Entry("empty string", "", `''`),
+			Entry("plain string", "containerd.service", `'containerd.service'`),
+			Entry("string with spaces and metacharacters", "foo bar; $(reboot) `id` \\ \"", `'foo bar; $(reboot) `+"`id`"+` \ "'`),
+			Entry("string with single quotes", "it's", `'it'\''s'`),
+		)
+
+		DescribeTable("should preserve the string when evaluated by a shell",
+			func(s string) {
+				if _, err := exec.LookPath("sh"); err != nil {
+					Skip("sh is not available")
+				}
+
+				out, err := exec.Command("sh", "-c", "printf '%s' "+Quote(s)).Output() // #nosec G204 -- the command is under test
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(out)).To(Equal(s))
+			},
+
+			Entry("plain string", "containerd.service"),
+			Entry("string with metacharacters", "foo'; reboot; echo '$HOME `id` \\ \" * ?"),
+			Entry("string with line breaks", "foo\nbar\n"),
+		)
+	})
+
+	Describe("#Script", func() {
+		It("should render the steps", func() {
+			script := New().
+				Command("systemctl daemon-reload").
+				WriteFile("/etc/foo/bar.conf", []byte("foo=bar\n"), ptr.To(uint32(0600))).
+				WriteFile("/etc/baz", []byte("baz"), nil).
+				EnableUnit("foo.service").
+				RestartUnit("foo.service").
+				RestartUnitNoBlock("bar@1.service").
+				DisableUnit("baz.service").
+				StopUnitNoBlock("baz.service").
+				LoadModule("br_netfilter").
+				RunOrExit("/opt/bin/wait.sh", "--timeout", "10")
+
+			Expect(script.String()).To(Equal(`#!/bin/bash
+systemctl daemon-reload
+mkdir -p '/etc/foo'
+base64 -d > '/etc/foo/bar.conf' << 'EOF'
+Zm9vPWJhcgo=
+EOF
+chmod 0600 '/etc/foo/bar.conf'
+mkdir -p '/etc'
+base64 -d > '/etc/baz' << 'EOF'
+YmF6
+EOF
+systemctl enable 'foo.service'
+systemctl restart 'foo.service'
+systemctl restart --no-block 'bar@1.service'
+systemctl disable 'baz.service'
+systemctl stop --no-block 'baz.service'
+modprobe 'br_netfilter'
+'/opt/bin/wait.sh' '--timeout' '10' || exit 1
+`))
+		})
+
+		It("should quote all values", func() {
+			script := New().
+				WriteFile("/etc/it's", nil, nil).
+				EnableUnit("foo'; reboot; '.service").
+				RunOrExit("/opt/bin/it's", "$(reboot)")
+
+			Expect(script.Steps()).To(Equal([]string{
+				`mkdir -p '/etc'
+base64 -d > '/etc/it'\''s' << 'EOF'
+
+EOF`,
+				`systemctl enable 'foo'\''; reboot; '\''.service'`,
+				`'/opt/bin/it'\''s' '$(reboot)' || exit 1`,
+			}))
+		})
+
+		It("should append the steps of another script", func() {
+			Expect(New().Command("true").Append(New().LoadModule("nfsd")).Steps()).To(Equal([]string{"true", "modprobe 'nfsd'"}))
+		})
+	})
+})
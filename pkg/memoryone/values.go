@@ -13,6 +13,7 @@ import (
 	runtimeutils "k8s.io/apimachinery/pkg/util/runtime"
 
 	memoryonegardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/memoryonegardenlinux/v1alpha1"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
 )
 
 var decoder runtime.Decoder
@@ -30,7 +31,7 @@ func Configuration(osc *extensionsv1alpha1.OperatingSystemConfig) (*memoryonegar
 
 	obj := &memoryonegardenlinux.OperatingSystemConfiguration{}
 	if _, _, err := decoder.Decode(osc.Spec.ProviderConfig.Raw, nil, obj); err != nil {
-		return nil, fmt.Errorf("failed to decode provider config: %+v", err)
+		return nil, fmt.Errorf("%w: %+v", gardenlinux.ErrDecodeProviderConfig, err)
 	}
 
 	return obj, nil
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/controller/operatingsystemconfig"
+)
+
+// Options are options for rendering an OperatingSystemConfig.
+type Options struct {
+	// Actuator are the options of the actuator which renders the OperatingSystemConfig.
+	Actuator operatingsystemconfig.ActuatorOptions
+	// Purpose overrides the purpose of the OperatingSystemConfig if set. OperatingSystemConfigs without purpose are
+	// rendered with the purpose `provision`.
+	Purpose extensionsv1alpha1.OperatingSystemConfigPurpose
+}
+
+// ReconcileResult is the result of rendering an OperatingSystemConfig with purpose `reconcile`.
+type ReconcileResult struct {
+	// Units are the additional units which the extension adds to the OperatingSystemConfig.
+	Units []extensionsv1alpha1.Unit `json:"units,omitempty"`
+	// Files are the additional files which the extension adds to the OperatingSystemConfig.
+	Files []extensionsv1alpha1.File `json:"files,omitempty"`
+	// InPlaceUpdates is the in-place update configuration of the OperatingSystemConfig.
+	InPlaceUpdates *extensionsv1alpha1.InPlaceUpdatesStatus `json:"inPlaceUpdates,omitempty"`
+}
+
+// ReadObjects reads the objects of the given multi-document YAML or JSON manifest.
+func ReadObjects(r io.Reader) ([]client.Object, error) {
+	var (
+		objects []client.Object
+		decoder = serializer.NewCodecFactory(kubernetes.SeedScheme).UniversalDeserializer()
+		reader  = utilyaml.NewYAMLReader(bufio.NewReader(r))
+	)
+
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest: %w", err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode object: %w", err)
+		}
+
+		clientObj, ok := obj.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unsupported object of type %T", obj)
+		}
+		objects = append(objects, clientObj)
+	}
+
+	return objects, nil
+}
+
+// Render renders the only OperatingSystemConfig of the given objects like the extension does and writes the result to
+// the given writer. The other objects, e.g. the Secrets referenced by the files of the OperatingSystemConfig or the
+// Cluster of the shoot, are read by the actuator. The user data is written as is for the purpose `provision`, the
+// additional units and files are written as YAML for the purpose `reconcile`.
+func Render(ctx context.Context, w io.Writer, objects []client.Object, opts Options) error {
+	var (
+		osc    *extensionsv1alpha1.OperatingSystemConfig
+		others []client.Object
+	)
+
+	for _, obj := range objects {
+		o, ok := obj.(*extensionsv1alpha1.OperatingSystemConfig)
+		if !ok {
+			others = append(others, obj)
+			continue
+		}
+
+		if osc != nil {
+			return fmt.Errorf("found more than one OperatingSystemConfig: %s and %s", client.ObjectKeyFromObject(osc), client.ObjectKeyFromObject(o))
+		}
+		osc = o
+	}
+
+	if osc == nil {
+		return errors.New("found no OperatingSystemConfig")
+	}
+
+	if opts.Purpose != "" {
+		osc.Spec.Purpose = opts.Purpose
+	}
+	if osc.Spec.Purpose == "" {
+		osc.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeProvision
+	}
+
+	c := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(others...).Build()
+
+	userData, units, files, inPlaceUpdates, err := operatingsystemconfig.NewActuatorWithClient(c, opts.Actuator).Reconcile(ctx, logr.Discard(), osc)
+	if err != nil {
+		return fmt.Errorf("failed to render OperatingSystemConfig %s: %w", client.ObjectKeyFromObject(osc), err)
+	}
+
+	if osc.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+		_, err = w.Write(userData)
+		return err
+	}
+
+	out, err := yaml.Marshal(ReconcileResult{Units: units, Files: files, InPlaceUpdates: inPlaceUpdates})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(out)
+	return err
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package render_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	. "github.com/gardener/gardener-extension-os-gardenlinux/pkg/render"
+)
+
+var _ = Describe("Render", func() {
+	var (
+		ctx = context.TODO()
+
+		manifest string
+		out      *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		manifest = `---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: some-secret
+  namespace: default
+data:
+  some-key: c2VjcmV0LWRhdGE=
+---
+apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+metadata:
+  name: pool-01-original
+  namespace: default
+spec:
+  type: gardenlinux
+  purpose: provision
+  units:
+  - name: some-unit.service
+    content: foo
+  files:
+  - path: /some/file
+    permissions: 0600
+    content:
+      secretRef:
+        name: some-secret
+        dataKey: some-key
+`
+	})
+
+	readObjects := func(manifest string) []client.Object {
+		GinkgoHelper()
+
+		objects, err := ReadObjects(strings.NewReader(manifest))
+		Expect(err).NotTo(HaveOccurred())
+		return objects
+	}
+
+	Describe("#ReadObjects", func() {
+		It("should read all objects of a multi-document manifest", func() {
+			objects := readObjects(manifest)
+
+			Expect(objects).To(HaveLen(2))
+			Expect(objects[0]).To(BeAssignableToTypeOf(&corev1.Secret{}))
+			Expect(objects[1]).To(BeAssignableToTypeOf(&extensionsv1alpha1.OperatingSystemConfig{}))
+		})
+
+		It("should return an error for unknown kinds", func() {
+			_, err := ReadObjects(strings.NewReader("apiVersion: foo/v1\nkind: Bar\n"))
+			Expect(err).To(MatchError(ContainSubstring("failed to decode object")))
+		})
+	})
+
+	Describe("#Render", func() {
+		It("should print the user data with the contents of referenced secrets", func() {
+			Expect(Render(ctx, out, readObjects(manifest), Options{})).To(Succeed())
+
+			Expect(out.String()).To(HavePrefix("#!/bin/bash\n"))
+			Expect(out.String()).To(ContainSubstring(`base64 -d > '/some/file' << 'EOF'
+` + utils.EncodeBase64([]byte("secret-data")) + `
+EOF
+chmod 0600 '/some/file'`))
+			Expect(out.String()).To(ContainSubstring("systemctl enable 'some-unit.service'\nsystemctl restart --no-block 'some-unit.service'\n"))
+		})
+
+		It("should print the user data in the configured format", func() {
+			opts := Options{}
+			opts.Actuator.UserDataFormat = "cloud-config"
+
+			Expect(Render(ctx, out, readObjects(manifest), opts)).To(Succeed())
+			Expect(out.String()).To(HavePrefix("#cloud-config\n"))
+			Expect(out.String()).To(ContainSubstring("content: secret-data\n"))
+		})
+
+		It("should print the MemoryOne envelope", func() {
+			manifest = strings.Replace(manifest, "type: gardenlinux", "type: memoryone-gardenlinux", 1) + `  providerConfig:
+    apiVersion: memoryone-gardenlinux.os.extensions.gardener.cloud/v1alpha1
+    kind: OperatingSystemConfiguration
+    memoryTopology: "3"
+`
+
+			Expect(Render(ctx, out, readObjects(manifest), Options{})).To(Succeed())
+			Expect(out.String()).To(HavePrefix(`Content-Type: multipart/mixed; boundary="==BOUNDARY=="
+MIME-Version: 1.0
+--==BOUNDARY==
+Content-Type: text/x-vsmp; section=vsmp
+`))
+			Expect(out.String()).To(ContainSubstring("mem_topology=3\n"))
+		})
+
+		It("should print the units and files for purpose reconcile", func() {
+			Expect(Render(ctx, out, readObjects(manifest), Options{Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeReconcile})).To(Succeed())
+
+			result := &ReconcileResult{}
+			Expect(yaml.Unmarshal(out.Bytes(), result)).To(Succeed())
+			Expect(result.Units).To(ContainElement(HaveField("Name", "containerd.service")))
+			Expect(result.Files).To(ContainElement(HaveField("Path", "/etc/modules-load.d/gardener.conf")))
+		})
+
+		It("should render OperatingSystemConfigs without purpose for provisioning", func() {
+			manifest = strings.Replace(manifest, "  purpose: provision\n", "", 1)
+
+			Expect(Render(ctx, out, readObjects(manifest), Options{})).To(Succeed())
+			Expect(out.String()).To(HavePrefix("#!/bin/bash\n"))
+		})
+
+		It("should fail if a referenced secret is missing", func() {
+			Expect(Render(ctx, out, readObjects(manifest)[1:], Options{})).To(MatchError(ContainSubstring(`secrets "some-secret" not found`)))
+		})
+
+		It("should fail if there is not exactly one OperatingSystemConfig", func() {
+			objects := readObjects(manifest)
+
+			Expect(Render(ctx, out, objects[:1], Options{})).To(MatchError("found no OperatingSystemConfig"))
+			Expect(Render(ctx, out, append(objects, objects[1].DeepCopyObject().(client.Object)), Options{})).To(MatchError(ContainSubstring("found more than one OperatingSystemConfig")))
+		})
+	})
+})
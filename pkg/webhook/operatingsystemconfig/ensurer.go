@@ -17,6 +17,9 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	apisgardenlinux "github.com/gardener/gardener-extension-os-gardenlinux/pkg/apis/gardenlinux"
+	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
 )
 
 // NewEnsurer creates a new operatingsystemconfig ensurer.
@@ -40,7 +43,25 @@ const (
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the desired specification
 func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, gctx extensionscontextwebhook.GardenContext, _ *semver.Version, new, _ *kubeletconfigv1beta1.KubeletConfiguration) error {
 	e.logger.Info("Ensuring Kubelet cgroup driver")
-	return ensureKubeletUsesSystemdCgroupDriver(new)
+	if err := ensureKubeletUsesSystemdCgroupDriver(new); err != nil {
+		return err
+	}
+
+	osc := operatingSystemConfigFromContext(ctx)
+	if osc == nil {
+		return nil
+	}
+
+	config, err := gardenlinux.Configuration(osc)
+	if err != nil {
+		return err
+	}
+
+	if config.Swap != nil {
+		e.logger.Info("Ensuring Kubelet tolerates swap")
+		ensureKubeletToleratesSwap(new, config.Swap)
+	}
+	return nil
 }
 
 // EnsureContainerdConfig ensures the CRI config.
@@ -57,6 +78,13 @@ func ensureKubeletUsesSystemdCgroupDriver(kubeletConfig *kubeletconfigv1beta1.Ku
 	return nil
 }
 
+// ensureKubeletToleratesSwap ensures that the kubelet starts on nodes with swap and lets the pods use it according to
+// the configured swap behavior.
+func ensureKubeletToleratesSwap(kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration, swap *apisgardenlinux.Swap) {
+	kubeletConfig.FailSwapOn = ptr.To(false)
+	kubeletConfig.MemorySwap.SwapBehavior = string(ptr.Deref(swap.Behavior, apisgardenlinux.SwapBehaviorLimitedSwap))
+}
+
 // ensureContainerdUsesSystemdCgroupDriver ensures that the CRI configuration contains systemd as cgroup driver
 func ensureContainerdUsesSystemdCgroupDriver(containerdConfig *extensionsv1alpha1.CRIConfig) error {
 	containerdConfig.CgroupDriver = ptr.To(extensionsv1alpha1.CgroupDriverSystemd)
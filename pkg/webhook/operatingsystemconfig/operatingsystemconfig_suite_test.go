@@ -13,14 +13,19 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
 	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
 	"github.com/gardener/gardener/pkg/utils/test"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/gardenlinux"
 	"github.com/gardener/gardener-extension-os-gardenlinux/pkg/webhook/operatingsystemconfig"
@@ -130,6 +135,67 @@ var _ = Describe("Mutator", func() {
 			Expect(mutatedKubeletConfig.CgroupDriver).NotTo(Equal(operatingsystemconfig.KubeletCgroupDriverSystemd))
 			Expect(osc.Spec.CRIConfig.CgroupDriver).NotTo(Equal(extensionsv1alpha1.CgroupDriverSystemd))
 		})
+
+		Context("swap", func() {
+			BeforeEach(func() {
+				fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&extensionsv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+					Spec: extensionsv1alpha1.ClusterSpec{
+						Shoot: runtime.RawExtension{Raw: []byte(`{"apiVersion":"core.gardener.cloud/v1beta1","kind":"Shoot","spec":{"kubernetes":{"version":"1.33.0"}}}`)},
+					},
+				}).Build()
+				fakeMgr := test.FakeManager{Client: fakeClient}
+				mutator = operatingsystemconfig.NewMutator(genericmutator.NewMutator(
+					fakeMgr,
+					operatingsystemconfig.NewEnsurer(fakeMgr, logger),
+					oscutils.NewUnitSerializer(),
+					kubeletConfigCodec,
+					fciCodec,
+					logger,
+				))
+
+				osc.Namespace = "shoot--foo--bar"
+				osc.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeReconcile
+			})
+
+			It("should not change the swap settings of the kubelet if no swap is configured", func() {
+				Expect(mutator.Mutate(ctx, &osc, nil)).To(Succeed())
+
+				mutatedKubeletConfig, err := extractKubeletConfigCgroupDriver(osc.Spec.Files)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mutatedKubeletConfig.CgroupDriver).To(Equal(operatingsystemconfig.KubeletCgroupDriverSystemd))
+				Expect(mutatedKubeletConfig.FailSwapOn).To(BeNil())
+				Expect(mutatedKubeletConfig.MemorySwap.SwapBehavior).To(BeEmpty())
+			})
+
+			It("should let the kubelet tolerate swap with the default behavior", func() {
+				osc.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","swap":{"zram":{"size":"2Gi"}}}`)}
+
+				Expect(mutator.Mutate(ctx, &osc, nil)).To(Succeed())
+
+				mutatedKubeletConfig, err := extractKubeletConfigCgroupDriver(osc.Spec.Files)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mutatedKubeletConfig.FailSwapOn).To(PointTo(BeFalse()))
+				Expect(mutatedKubeletConfig.MemorySwap.SwapBehavior).To(Equal("LimitedSwap"))
+			})
+
+			It("should let the kubelet tolerate swap with the configured behavior", func() {
+				osc.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","swap":{"file":{"size":"4Gi"},"behavior":"NoSwap"}}`)}
+
+				Expect(mutator.Mutate(ctx, &osc, nil)).To(Succeed())
+
+				mutatedKubeletConfig, err := extractKubeletConfigCgroupDriver(osc.Spec.Files)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mutatedKubeletConfig.FailSwapOn).To(PointTo(BeFalse()))
+				Expect(mutatedKubeletConfig.MemorySwap.SwapBehavior).To(Equal("NoSwap"))
+			})
+
+			It("should fail if the provider config is invalid", func() {
+				osc.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlinux.os.extensions.gardener.cloud/v1alpha1","kind":"OperatingSystemConfiguration","swap":{}}`)}
+
+				Expect(mutator.Mutate(ctx, &osc, nil)).To(MatchError(ContainSubstring("spec.providerConfig.swap")))
+			})
+		})
 	})
 })
 
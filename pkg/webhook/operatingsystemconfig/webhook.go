@@ -5,6 +5,8 @@
 package operatingsystemconfig
 
 import (
+	"context"
+
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -34,14 +36,14 @@ func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 
 	fciCodec := oscutils.NewFileContentInlineCodec()
 
-	mutator := genericmutator.NewMutator(
+	mutator := NewMutator(genericmutator.NewMutator(
 		mgr,
 		NewEnsurer(mgr, logger),
 		oscutils.NewUnitSerializer(),
 		kubelet.NewConfigCodec(fciCodec),
 		fciCodec,
 		logger,
-	)
+	))
 
 	objTypes := []extensionswebhook.Type{
 		{Obj: &extensionsv1alpha1.OperatingSystemConfig{}},
@@ -65,6 +67,33 @@ func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	return webhook, nil
 }
 
+type operatingSystemConfigContextKey struct{}
+
+// NewMutator returns a mutator which passes the mutated OperatingSystemConfig to the ensurer via the context before it
+// calls the given mutator. The generic mutator does not pass it to the ensurer, which however requires its provider
+// configuration.
+func NewMutator(m extensionswebhook.Mutator) extensionswebhook.Mutator {
+	return &mutator{mutator: m}
+}
+
+type mutator struct {
+	mutator extensionswebhook.Mutator
+}
+
+func (m *mutator) Mutate(ctx context.Context, new, old client.Object) error {
+	if osc, ok := new.(*extensionsv1alpha1.OperatingSystemConfig); ok {
+		ctx = context.WithValue(ctx, operatingSystemConfigContextKey{}, osc)
+	}
+	return m.mutator.Mutate(ctx, new, old)
+}
+
+// operatingSystemConfigFromContext returns the OperatingSystemConfig which is mutated or nil if the context does not
+// carry one.
+func operatingSystemConfigFromContext(ctx context.Context) *extensionsv1alpha1.OperatingSystemConfig {
+	osc, _ := ctx.Value(operatingSystemConfigContextKey{}).(*extensionsv1alpha1.OperatingSystemConfig)
+	return osc
+}
+
 // isGardenLinuxOsc returns a predicate that filters OperatingSystemConfigs just for Garden Linux
 func isGardenLinuxOsc() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {